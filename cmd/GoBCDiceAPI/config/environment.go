@@ -8,13 +8,26 @@ import (
 
 	"github.com/labstack/echo"
 	"github.com/labstack/echo/middleware"
+	"github.com/raa0121/GoBCDice/pkg/room"
 )
 
 var (
 	// Environment is the environment for the application
 	Environment = os.Getenv("ECHO_ENV")
+	// RoomStorageDir is the directory where rooms are saved.
+	// Rooms are kept in memory if it is empty.
+	RoomStorageDir = os.Getenv("ROOM_STORAGE_DIR")
 )
 
+// NewRoomStore returns the room store selected by RoomStorageDir.
+func NewRoomStore() (room.Store, error) {
+	if RoomStorageDir == "" {
+		return room.NewMemoryStore(), nil
+	}
+
+	return room.NewFileStore(RoomStorageDir)
+}
+
 func Setup(e *echo.Echo) {
 	if Environment == "" {
 		Environment = "development"
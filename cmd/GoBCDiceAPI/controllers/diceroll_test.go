@@ -0,0 +1,123 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/helpers"
+)
+
+func TestDiceRoll(t *testing.T) {
+	s := S{}
+	s.SetUpSuite(nil)
+
+	testcases := []struct {
+		method   string
+		params   url.Values
+		expected helpers.ResponseMap
+	}{
+		{
+			method: "GET",
+			params: url.Values{"system": {"DiceBot"}, "command": {"2D1+1"}},
+			expected: helpers.ResponseMap{
+				"ok":                 true,
+				"successCheckResult": "UNSPECIFIED",
+				"result":             ": (2D1+1) ＞ 2[1,1]+1 ＞ 3",
+				"secret":             false,
+				"dices": []interface{}{
+					map[string]interface{}{"faces": float64(1), "value": float64(1)},
+					map[string]interface{}{"faces": float64(1), "value": float64(1)},
+				},
+			},
+		},
+		{
+			method: "POST",
+			params: url.Values{"system": {"DiceBot"}, "command": {"S1D1"}},
+			expected: helpers.ResponseMap{
+				"ok":                 true,
+				"successCheckResult": "UNSPECIFIED",
+				"result":             ": (1D1) ＞ 1[1] ＞ 1",
+				"secret":             true,
+				"dices": []interface{}{
+					map[string]interface{}{"faces": float64(1), "value": float64(1)},
+				},
+			},
+		},
+		{
+			method: "GET",
+			params: url.Values{"system": {"DiceBot"}, "command": {"C(1+2)"}},
+			expected: helpers.ResponseMap{
+				"ok":                 true,
+				"successCheckResult": "UNSPECIFIED",
+				"result":             ": C(1+2) ＞ 計算結果 ＞ 3",
+				"secret":             false,
+				"dices":              []interface{}{},
+			},
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.method+" "+test.params.Encode(), func(t *testing.T) {
+			rec := s.PerformRequest(test.method, "/v1/diceroll", test.params)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("wrong code: got=%v want=%v", rec.Code, http.StatusOK)
+			}
+
+			var r helpers.ResponseMap
+			err := json.NewDecoder(strings.NewReader(rec.Body.String())).Decode(&r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(r, test.expected) {
+				t.Errorf("wrong response: got=%+v, want=%+v", r, test.expected)
+			}
+		})
+	}
+}
+
+func TestDiceRoll_BadRequest(t *testing.T) {
+	s := S{}
+	s.SetUpSuite(nil)
+
+	testcases := []struct {
+		params  url.Values
+		message string
+	}{
+		{url.Values{"command": {"2D6"}}, "unsupported dicebot"},
+		{url.Values{"system": {"Unknown"}, "command": {"2D6"}}, "unsupported dicebot"},
+		{url.Values{"system": {"DiceBot"}}, "unsupported command"},
+		{url.Values{"system": {"DiceBot"}, "command": {"xyz"}}, "unsupported command"},
+		{url.Values{"system": {"DiceBot"}, "command": {"99999999D99999999"}}, "limit exceeded: dice per roll 99999999 > 1000"},
+		{url.Values{"system": {"DiceBot"}, "command": {"1D99999"}}, "limit exceeded: sides 99999 > 10000"},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.params.Encode(), func(t *testing.T) {
+			rec := s.PerformRequest("GET", "/v1/diceroll", test.params)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("wrong code: got=%v want=%v", rec.Code, http.StatusBadRequest)
+			}
+
+			var r helpers.ResponseMap
+			err := json.NewDecoder(strings.NewReader(rec.Body.String())).Decode(&r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := helpers.ResponseMap{
+				"ok":      false,
+				"message": test.message,
+			}
+			if !reflect.DeepEqual(r, expected) {
+				t.Errorf("wrong response: got=%+v, want=%+v", r, expected)
+			}
+		})
+	}
+}
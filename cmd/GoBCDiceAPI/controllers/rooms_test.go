@@ -0,0 +1,152 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/helpers"
+)
+
+// decodeResponse は応答の本文をJSONとして解釈する。
+func decodeResponse(t *testing.T, rec *httptest.ResponseRecorder) helpers.ResponseMap {
+	var r helpers.ResponseMap
+	err := json.NewDecoder(strings.NewReader(rec.Body.String())).Decode(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
+
+// hiddenFlags は、ダイスロールの一覧から、結果が隠されているかどうかを取り出す。
+func hiddenFlags(rolls []interface{}) []bool {
+	flags := []bool{}
+	for _, roll := range rolls {
+		_, hasResult := roll.(map[string]interface{})["result"]
+		flags = append(flags, !hasResult)
+	}
+
+	return flags
+}
+
+func TestRooms(t *testing.T) {
+	s := S{}
+	s.SetUpSuite(nil)
+
+	rec := s.PerformRequest("POST", "/v1/rooms", url.Values{"system": {"DiceBot"}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("wrong code: got=%v want=%v", rec.Code, http.StatusOK)
+	}
+
+	created := decodeResponse(t, rec)
+	roomMap := created["room"].(map[string]interface{})
+	id := roomMap["id"].(string)
+	gmToken := created["gmToken"].(string)
+
+	if roomMap["system"] != "DiceBot" {
+		t.Errorf("wrong system: got=%v want=%v", roomMap["system"], "DiceBot")
+	}
+
+	roomPath := "/v1/rooms/" + id
+
+	// ルームの情報にはGM用のトークンを含めない
+	rec = s.PerformRequest("GET", roomPath, url.Values{})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("wrong code: got=%v want=%v", rec.Code, http.StatusOK)
+	}
+	if _, found := decodeResponse(t, rec)["gmToken"]; found {
+		t.Error("ルームの情報にGM用のトークンが含まれている")
+	}
+
+	for _, c := range []string{"2D1", "S2D1"} {
+		rec = s.PerformRequest("POST", roomPath+"/diceroll", url.Values{"command": {c}})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("%s: wrong code: got=%v want=%v", c, rec.Code, http.StatusOK)
+		}
+
+		// ダイスロールを行った本人には、シークレットロールの結果も返す
+		roll := decodeResponse(t, rec)["roll"].(map[string]interface{})
+		if roll["result"] != ": (2D1) ＞ 2[1,1] ＞ 2" {
+			t.Errorf("%s: wrong result: got=%v", c, roll["result"])
+		}
+	}
+
+	getHistory := func(token string) []bool {
+		rec := s.PerformRequest("GET", roomPath+"/history", url.Values{"gm_token": {token}})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("wrong code: got=%v want=%v", rec.Code, http.StatusOK)
+		}
+
+		return hiddenFlags(decodeResponse(t, rec)["history"].([]interface{}))
+	}
+
+	if actual := getHistory(""); !(len(actual) == 2 && !actual[0] && actual[1]) {
+		t.Errorf("GM以外へのシークレットロールの隠し方が異なる: %v", actual)
+	}
+
+	if actual := getHistory(gmToken); !(len(actual) == 2 && !actual[0] && !actual[1]) {
+		t.Errorf("GMにシークレットロールが隠されている: %v", actual)
+	}
+
+	rec = s.PerformRequest("POST", roomPath+"/reveal", url.Values{"gm_token": {"wrong"}})
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("wrong code: got=%v want=%v", rec.Code, http.StatusForbidden)
+	}
+
+	rec = s.PerformRequest("POST", roomPath+"/reveal", url.Values{"gm_token": {gmToken}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("wrong code: got=%v want=%v", rec.Code, http.StatusOK)
+	}
+	if revealed := decodeResponse(t, rec)["revealed"].([]interface{}); len(revealed) != 1 {
+		t.Errorf("公開されたダイスロールの数が異なる: got=%d want=%d", len(revealed), 1)
+	}
+
+	if actual := getHistory(""); !(len(actual) == 2 && !actual[0] && !actual[1]) {
+		t.Errorf("公開したシークレットロールが隠されている: %v", actual)
+	}
+}
+
+func TestRooms_Errors(t *testing.T) {
+	s := S{}
+	s.SetUpSuite(nil)
+
+	rec := s.PerformRequest("POST", "/v1/rooms", url.Values{"system": {"DiceBot"}})
+	id := decodeResponse(t, rec)["room"].(map[string]interface{})["id"].(string)
+
+	testcases := []struct {
+		method  string
+		path    string
+		params  url.Values
+		code    int
+		message string
+	}{
+		{"POST", "/v1/rooms", url.Values{}, http.StatusBadRequest, "unsupported dicebot"},
+		{"POST", "/v1/rooms", url.Values{"system": {"Unknown"}}, http.StatusBadRequest, "unsupported dicebot"},
+		{"GET", "/v1/rooms/0123abcd", url.Values{}, http.StatusNotFound, "room not found"},
+		{"GET", "/v1/rooms/0123abcd/history", url.Values{}, http.StatusNotFound, "room not found"},
+		{"POST", "/v1/rooms/0123abcd/diceroll", url.Values{"command": {"2D6"}}, http.StatusNotFound, "room not found"},
+		{"POST", "/v1/rooms/0123abcd/reveal", url.Values{}, http.StatusNotFound, "room not found"},
+		{"POST", "/v1/rooms/" + id + "/diceroll", url.Values{}, http.StatusBadRequest, "unsupported command"},
+		{"POST", "/v1/rooms/" + id + "/diceroll", url.Values{"command": {"xyz"}}, http.StatusBadRequest, "unsupported command"},
+		{"POST", "/v1/rooms/" + id + "/reveal", url.Values{}, http.StatusForbidden, "forbidden"},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.method+" "+test.path+" "+test.params.Encode(), func(t *testing.T) {
+			rec := s.PerformRequest(test.method, test.path, test.params)
+
+			if rec.Code != test.code {
+				t.Fatalf("wrong code: got=%v want=%v", rec.Code, test.code)
+			}
+
+			r := decodeResponse(t, rec)
+			if r["message"] != test.message {
+				t.Errorf("wrong message: got=%v want=%v", r["message"], test.message)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"github.com/labstack/echo"
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/config"
 	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/controllers/v1"
 )
 
@@ -22,4 +23,15 @@ func setupV1(g *echo.Group) {
 	systems.Setup()
 	names := v1.NewNamesController(g)
 	names.Setup()
+	diceRoll := v1.NewDiceRollController(g)
+	diceRoll.Setup()
+	systemInfo := v1.NewSystemInfoController(g)
+	systemInfo.Setup()
+
+	roomStore, err := config.NewRoomStore()
+	if err != nil {
+		panic(err)
+	}
+	rooms := v1.NewRoomsController(g, roomStore)
+	rooms.Setup()
 }
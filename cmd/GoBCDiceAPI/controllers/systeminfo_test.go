@@ -0,0 +1,90 @@
+package controllers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/helpers"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/cthulhu7th"
+)
+
+func TestSystemInfo(t *testing.T) {
+	s := S{}
+	s.SetUpSuite(nil)
+
+	info := cthulhu7th.BasicInfo()
+	prefixes := make([]interface{}, 0, len(info.Prefixes))
+	for _, p := range info.Prefixes {
+		prefixes = append(prefixes, p)
+	}
+
+	expected := helpers.ResponseMap{
+		"ok": true,
+		"systeminfo": map[string]interface{}{
+			"gameType": info.GameID,
+			"name":     info.GameName,
+			"sortKey":  info.SortKey,
+			"info":     info.Usage,
+			"prefixs":  prefixes,
+		},
+	}
+
+	// 別名でも取得できることを確認する
+	for _, system := range []string{"Cthulhu7th", "CoC7th"} {
+		t.Run(system, func(t *testing.T) {
+			rec := s.PerformRequest("GET", "/v1/systeminfo", url.Values{"system": {system}})
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("wrong code: got=%v want=%v", rec.Code, http.StatusOK)
+			}
+
+			var r helpers.ResponseMap
+			err := json.NewDecoder(strings.NewReader(rec.Body.String())).Decode(&r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(r, expected) {
+				t.Errorf("wrong response: got=%+v, want=%+v", r, expected)
+			}
+		})
+	}
+}
+
+func TestSystemInfo_NotFound(t *testing.T) {
+	s := S{}
+	s.SetUpSuite(nil)
+
+	testcases := []url.Values{
+		{},
+		{"system": {"Unknown"}},
+	}
+
+	for _, params := range testcases {
+		t.Run(params.Encode(), func(t *testing.T) {
+			rec := s.PerformRequest("GET", "/v1/systeminfo", params)
+
+			if rec.Code != http.StatusNotFound {
+				t.Fatalf("wrong code: got=%v want=%v", rec.Code, http.StatusNotFound)
+			}
+
+			var r helpers.ResponseMap
+			err := json.NewDecoder(strings.NewReader(rec.Body.String())).Decode(&r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := helpers.ResponseMap{
+				"ok":      false,
+				"message": "unsupported dicebot",
+			}
+			if !reflect.DeepEqual(r, expected) {
+				t.Errorf("wrong response: got=%+v, want=%+v", r, expected)
+			}
+		})
+	}
+}
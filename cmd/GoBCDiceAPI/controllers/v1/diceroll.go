@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo"
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/helpers"
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/models"
+	"github.com/raa0121/GoBCDice/pkg/bcdice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/list"
+)
+
+// DiceRollController はダイスロールを行うコントローラ。
+type DiceRollController struct {
+	Group *echo.Group
+	// Engine はすべてのリクエストで共有する、コマンドを実行するエンジン。
+	Engine *bcdice.Engine
+}
+
+// NewDiceRollController は新しいDiceRollControllerを返す。
+//
+// ダイス供給機には、シードを推測されないCryptoダイス供給機を使用する。
+func NewDiceRollController(g *echo.Group) *DiceRollController {
+	return &DiceRollController{
+		Group:  g,
+		Engine: bcdice.NewEngine(feeder.NewCrypto()),
+	}
+}
+
+// diceRoll は指定されたゲームシステムでコマンドを実行し、その結果を返す。
+//
+// system: ゲーム識別子,
+// command: 実行するコマンド。
+func (controller *DiceRollController) diceRoll(c echo.Context) error {
+	system := c.FormValue("system")
+	command := c.FormValue("command")
+
+	if system == "" {
+		return helpers.JSONResponseError(c,
+			helpers.NewResponseError(http.StatusBadRequest, "unsupported dicebot"))
+	}
+
+	if _, err := list.Find(system); err != nil {
+		return helpers.JSONResponseError(c,
+			helpers.NewResponseError(http.StatusBadRequest, "unsupported dicebot"))
+	}
+
+	if command == "" {
+		return helpers.JSONResponseError(c,
+			helpers.NewResponseError(http.StatusBadRequest, "unsupported command"))
+	}
+
+	result, err := controller.Engine.ExecuteCommand(system, command)
+	if err != nil {
+		return commandError(c, err)
+	}
+
+	return helpers.JSONResponseObject(c, http.StatusOK, models.NewDiceRoll(result))
+}
+
+// commandError は、コマンドの実行で発生したエラーに対応する応答を返す。
+//
+// 上限を超えた場合は、超えた上限をメッセージに含める。
+func commandError(c echo.Context, err error) error {
+	var exceeded *limits.ExceededError
+	if errors.As(err, &exceeded) {
+		return helpers.JSONResponseError(c,
+			helpers.NewResponseError(http.StatusBadRequest, exceeded.Error()))
+	}
+
+	return helpers.JSONResponseError(c,
+		helpers.NewResponseError(http.StatusBadRequest, "unsupported command"))
+}
+
+// Setup はコントローラの初期設定を行う。
+func (controller *DiceRollController) Setup() {
+	controller.Group.Add("GET", "/diceroll", controller.diceRoll)
+	controller.Group.Add("POST", "/diceroll", controller.diceRoll)
+}
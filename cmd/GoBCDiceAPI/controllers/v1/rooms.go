@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo"
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/helpers"
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/models"
+	"github.com/raa0121/GoBCDice/pkg/bcdice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/room"
+)
+
+// RoomsController はルームを扱うコントローラ。
+type RoomsController struct {
+	Group *echo.Group
+	// Store はルームの保存先。
+	Store room.Store
+	// Engine はすべてのリクエストで共有する、コマンドを実行するエンジン。
+	Engine *bcdice.Engine
+	// Now は現在時刻を返す関数。
+	Now func() time.Time
+}
+
+// NewRoomsController は、storeにルームを保存する新しいRoomsControllerを返す。
+//
+// ダイス供給機には、シードを推測されないCryptoダイス供給機を使用する。
+func NewRoomsController(g *echo.Group, store room.Store) *RoomsController {
+	return &RoomsController{
+		Group:  g,
+		Store:  store,
+		Engine: bcdice.NewEngine(feeder.NewCrypto()),
+		Now:    time.Now,
+	}
+}
+
+// roomNotFound はルームが見つからなかったときの応答を返す。
+func roomNotFound(c echo.Context) error {
+	return helpers.JSONResponseError(c,
+		helpers.NewResponseError(http.StatusNotFound, "room not found"))
+}
+
+// storeError は、ルームの保存先で発生したエラーに対応する応答を返す。
+func storeError(c echo.Context, err error) error {
+	if errors.Is(err, room.ErrNotFound) {
+		return roomNotFound(c)
+	}
+
+	return helpers.JSONResponseError(c,
+		helpers.NewResponseError(http.StatusInternalServerError, "room storage error"))
+}
+
+// createRoom は新しいルームを作成する。
+//
+// system: ゲーム識別子。
+//
+// 応答に含まれるGM用のトークンは、このときにのみ返される。
+func (controller *RoomsController) createRoom(c echo.Context) error {
+	system := c.FormValue("system")
+	if system == "" {
+		return helpers.JSONResponseError(c,
+			helpers.NewResponseError(http.StatusBadRequest, "unsupported dicebot"))
+	}
+
+	r, err := room.New(system, controller.Now())
+	if err != nil {
+		return helpers.JSONResponseError(c,
+			helpers.NewResponseError(http.StatusBadRequest, "unsupported dicebot"))
+	}
+
+	if err := controller.Store.Create(r); err != nil {
+		return storeError(c, err)
+	}
+
+	return helpers.JSONResponseObject(c, http.StatusOK, models.NewRoom(r, true))
+}
+
+// getRoom はルームの情報を返す。
+func (controller *RoomsController) getRoom(c echo.Context) error {
+	r, err := controller.Store.Get(c.Param("id"))
+	if err != nil {
+		return storeError(c, err)
+	}
+
+	return helpers.JSONResponseObject(c, http.StatusOK, models.NewRoom(r, false))
+}
+
+// diceRoll は、ルームのゲームシステムでコマンドを実行し、履歴に追加する。
+//
+// command: 実行するコマンド。
+//
+// 応答はダイスロールを行った本人に返すため、シークレットロールの結果も含む。
+func (controller *RoomsController) diceRoll(c echo.Context) error {
+	id := c.Param("id")
+
+	r, err := controller.Store.Get(id)
+	if err != nil {
+		return storeError(c, err)
+	}
+
+	command := c.FormValue("command")
+	if command == "" {
+		return helpers.JSONResponseError(c,
+			helpers.NewResponseError(http.StatusBadRequest, "unsupported command"))
+	}
+
+	result, err := controller.Engine.ExecuteCommand(r.GameID, command)
+	if err != nil {
+		return commandError(c, err)
+	}
+
+	var roll *room.Roll
+	err = controller.Store.Update(id, func(r *room.Room) error {
+		roll = r.AddRoll(command, result, controller.Now())
+		return nil
+	})
+	if err != nil {
+		return storeError(c, err)
+	}
+
+	return helpers.JSONResponseObject(c, http.StatusOK, models.NewRoomRoll(roll))
+}
+
+// getHistory はルームのダイスロールの履歴を返す。
+//
+// gm_token: GM用のトークン。
+//
+// GM用のトークンが正しくない場合、公開されていないシークレットロールの結果は隠される。
+func (controller *RoomsController) getHistory(c echo.Context) error {
+	r, err := controller.Store.Get(c.Param("id"))
+	if err != nil {
+		return storeError(c, err)
+	}
+
+	isGM := r.IsGM(c.FormValue("gm_token"))
+
+	return helpers.JSONResponseObject(c, http.StatusOK, models.NewRoomHistory(r.Rolls, isGM))
+}
+
+// revealSecretRolls は、公開されていないシークレットロールをすべて公開する。
+//
+// gm_token: GM用のトークン。
+func (controller *RoomsController) revealSecretRolls(c echo.Context) error {
+	id := c.Param("id")
+	gmToken := c.FormValue("gm_token")
+
+	// GMでない場合に返すエラー
+	errForbidden := helpers.NewResponseError(http.StatusForbidden, "forbidden")
+
+	var revealed []*room.Roll
+	err := controller.Store.Update(id, func(r *room.Room) error {
+		if !r.IsGM(gmToken) {
+			return errForbidden
+		}
+
+		revealed = r.RevealSecretRolls()
+		return nil
+	})
+	if err == errForbidden {
+		return helpers.JSONResponseError(c, errForbidden)
+	}
+	if err != nil {
+		return storeError(c, err)
+	}
+
+	return helpers.JSONResponseObject(c, http.StatusOK, models.NewRevealedRolls(revealed))
+}
+
+// Setup はコントローラの初期設定を行う。
+func (controller *RoomsController) Setup() {
+	controller.Group.Add("POST", "/rooms", controller.createRoom)
+	controller.Group.Add("GET", "/rooms/:id", controller.getRoom)
+	controller.Group.Add("POST", "/rooms/:id/diceroll", controller.diceRoll)
+	controller.Group.Add("GET", "/rooms/:id/history", controller.getHistory)
+	controller.Group.Add("POST", "/rooms/:id/reveal", controller.revealSecretRolls)
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo"
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/helpers"
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/models"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/list"
+)
+
+// SystemInfoController はダイスボットの情報を返すコントローラ。
+type SystemInfoController struct {
+	Group *echo.Group
+}
+
+// NewSystemInfoController は新しいSystemInfoControllerを返す。
+func NewSystemInfoController(g *echo.Group) *SystemInfoController {
+	return &SystemInfoController{
+		Group: g,
+	}
+}
+
+// getSystemInfo は指定されたゲームシステムのダイスボットの情報を返す。
+//
+// system: ゲーム識別子。
+func (controller *SystemInfoController) getSystemInfo(c echo.Context) error {
+	system := c.FormValue("system")
+	if system == "" {
+		return helpers.JSONResponseError(c,
+			helpers.NewResponseError(http.StatusNotFound, "unsupported dicebot"))
+	}
+
+	constructor, err := list.Find(system)
+	if err != nil {
+		return helpers.JSONResponseError(c,
+			helpers.NewResponseError(http.StatusNotFound, "unsupported dicebot"))
+	}
+
+	return helpers.JSONResponseObject(c, http.StatusOK, models.NewSystemInfo(constructor()))
+}
+
+// Setup はコントローラの初期設定を行う。
+func (controller *SystemInfoController) Setup() {
+	controller.Group.Add("GET", "/systeminfo", controller.getSystemInfo)
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/helpers"
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+)
+
+// DiceRoll はダイスロールの結果を表す構造体。
+type DiceRoll struct {
+	Result *command.Result
+}
+
+// NewDiceRoll は、コマンドの実行結果からダイスロールの結果を構築する。
+func NewDiceRoll(r *command.Result) *DiceRoll {
+	return &DiceRoll{
+		Result: r,
+	}
+}
+
+// ToResponseMap は、BCDice-APIと互換性のある形式の応答を返す。
+//
+// BCDice-APIの項目に加えて、成功判定結果を "successCheckResult" として返す。
+func (d *DiceRoll) ToResponseMap() helpers.ResponseMap {
+	dices := make([]helpers.ResponseMap, 0, len(d.Result.RolledDice))
+	for _, die := range d.Result.RolledDice {
+		dices = append(dices, helpers.ResponseMap{
+			"faces": die.Sides,
+			"value": die.Value,
+		})
+	}
+
+	return helpers.ResponseMap{
+		"result": ": " + d.Result.JoinedMessageParts(),
+		"secret": d.Result.IsSecret,
+		"dices":  dices,
+
+		"successCheckResult": d.Result.SuccessCheckResult.String(),
+	}
+}
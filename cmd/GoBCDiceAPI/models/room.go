@@ -0,0 +1,137 @@
+package models
+
+import (
+	"time"
+
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/helpers"
+	"github.com/raa0121/GoBCDice/pkg/room"
+)
+
+// Room はルームの情報を表す構造体。
+type Room struct {
+	Room *room.Room
+	// IncludeGMToken はGM用のトークンを応答に含めるかどうか。
+	IncludeGMToken bool
+}
+
+// NewRoom は、ルームの情報を構築する。
+func NewRoom(r *room.Room, includeGMToken bool) *Room {
+	return &Room{
+		Room:           r,
+		IncludeGMToken: includeGMToken,
+	}
+}
+
+// ToResponseMap は応答を返す。
+//
+// GM用のトークンは、ルームを作成したときのみ応答に含める。
+func (r *Room) ToResponseMap() helpers.ResponseMap {
+	roomMap := helpers.ResponseMap{
+		"id":        r.Room.ID,
+		"system":    r.Room.GameID,
+		"createdAt": r.Room.CreatedAt.Format(time.RFC3339),
+		"rollCount": len(r.Room.Rolls),
+	}
+
+	body := helpers.ResponseMap{
+		"room": roomMap,
+	}
+
+	if r.IncludeGMToken {
+		body["gmToken"] = r.Room.GMToken
+	}
+
+	return body
+}
+
+// RoomRoll はルームで行われたダイスロールを表す構造体。
+type RoomRoll struct {
+	Roll *room.Roll
+}
+
+// NewRoomRoll は、ルームで行われたダイスロールの情報を構築する。
+func NewRoomRoll(roll *room.Roll) *RoomRoll {
+	return &RoomRoll{
+		Roll: roll,
+	}
+}
+
+// ToResponseMap は応答を返す。
+//
+// ダイスロールを行った本人への応答のため、シークレットロールも隠さない。
+func (r *RoomRoll) ToResponseMap() helpers.ResponseMap {
+	return helpers.ResponseMap{
+		"roll": roomRollResponseMap(r.Roll, false),
+	}
+}
+
+// RoomRolls はルームで行われたダイスロールの一覧を表す構造体。
+type RoomRolls struct {
+	// Key は応答でダイスロールの一覧を格納するキー。
+	Key   string
+	Rolls []*room.Roll
+	// IsGM は要求者がGMかどうか。
+	// GMでなければ、公開されていないシークレットロールの結果を隠す。
+	IsGM bool
+}
+
+// NewRoomHistory は、ルームのダイスロールの履歴を構築する。
+func NewRoomHistory(rolls []*room.Roll, isGM bool) *RoomRolls {
+	return &RoomRolls{
+		Key:   "history",
+		Rolls: rolls,
+		IsGM:  isGM,
+	}
+}
+
+// NewRevealedRolls は、公開したシークレットロールの一覧を構築する。
+func NewRevealedRolls(rolls []*room.Roll) *RoomRolls {
+	return &RoomRolls{
+		Key:   "revealed",
+		Rolls: rolls,
+		IsGM:  true,
+	}
+}
+
+// ToResponseMap は応答を返す。
+func (r *RoomRolls) ToResponseMap() helpers.ResponseMap {
+	rolls := make([]helpers.ResponseMap, 0, len(r.Rolls))
+	for _, roll := range r.Rolls {
+		rolls = append(rolls, roomRollResponseMap(roll, !r.IsGM && roll.IsHidden()))
+	}
+
+	return helpers.ResponseMap{
+		r.Key: rolls,
+	}
+}
+
+// roomRollResponseMap は、ダイスロールを応答の形式に変換する。
+//
+// hiddenがtrueの場合は、コマンドと結果を含めない。
+func roomRollResponseMap(roll *room.Roll, hidden bool) helpers.ResponseMap {
+	m := helpers.ResponseMap{
+		"id":       roll.ID,
+		"time":     roll.Time.Format(time.RFC3339),
+		"secret":   roll.Result.IsSecret,
+		"revealed": roll.Revealed,
+	}
+
+	if hidden {
+		return m
+	}
+
+	dices := make([]helpers.ResponseMap, 0, len(roll.Result.RolledDice))
+	for _, die := range roll.Result.RolledDice {
+		dices = append(dices, helpers.ResponseMap{
+			"faces": die.Sides,
+			"value": die.Value,
+		})
+	}
+
+	m["command"] = roll.Command
+	m["result"] = ": " + roll.Result.JoinedMessageParts()
+	m["dices"] = dices
+	m["successCheckResult"] = roll.Result.SuccessCheckResult.String()
+
+	return m
+}
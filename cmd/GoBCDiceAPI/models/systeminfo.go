@@ -0,0 +1,36 @@
+package models
+
+import (
+	"github.com/raa0121/GoBCDice/cmd/GoBCDiceAPI/helpers"
+	"github.com/raa0121/GoBCDice/pkg/dicebot"
+)
+
+// SystemInfo はダイスボットの情報を表す構造体。
+type SystemInfo struct {
+	DiceBot dicebot.DiceBot
+}
+
+// NewSystemInfo は、ダイスボットの情報を構築する。
+func NewSystemInfo(b dicebot.DiceBot) *SystemInfo {
+	return &SystemInfo{
+		DiceBot: b,
+	}
+}
+
+// ToResponseMap は、BCDice-APIと互換性のある形式の応答を返す。
+func (s *SystemInfo) ToResponseMap() helpers.ResponseMap {
+	prefixes := s.DiceBot.Prefixes()
+	if prefixes == nil {
+		prefixes = []string{}
+	}
+
+	return helpers.ResponseMap{
+		"systeminfo": helpers.ResponseMap{
+			"gameType": s.DiceBot.GameID(),
+			"name":     s.DiceBot.GameName(),
+			"sortKey":  s.DiceBot.SortKey(),
+			"info":     s.DiceBot.Usage(),
+			"prefixs":  prefixes,
+		},
+	}
+}
@@ -10,9 +10,11 @@ import (
 	"github.com/chzyer/readline"
 	"github.com/raa0121/GoBCDice/pkg/bcdice"
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/command"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/parser"
 	"github.com/raa0121/GoBCDice/pkg/core/util"
 	dicebotlist "github.com/raa0121/GoBCDice/pkg/dicebot/list"
@@ -45,6 +47,7 @@ const (
 	COMMAND_SET_DICE_QUEUE = "set-dice-queue"
 	COMMAND_SET_GAME       = "set-game"
 	COMMAND_LIST_GAMES     = "list-games"
+	COMMAND_SET_LOCALE     = "set-locale"
 
 	COMMAND_HELP = "help"
 	COMMAND_QUIT = "quit"
@@ -134,6 +137,12 @@ func init() {
 			Description: "利用可能なゲームシステムの識別子の一覧を出力します",
 			Handler:     listGames,
 		},
+		{
+			Name:            COMMAND_SET_LOCALE,
+			ArgsDescription: "言語",
+			Description:     "結果のメッセージの言語を設定します - ja: 日本語、en: 英語、ko: 韓国語、zh: 中国語",
+			Handler:         setLocale,
+		},
 		{
 			Name:            COMMAND_SET_DIE_FEEDER,
 			ArgsDescription: "mt/queue",
@@ -174,6 +183,14 @@ func init() {
 			readline.PcItem(gameId),
 		)
 	}
+
+	commandSetLocale := commandMap[COMMAND_SET_LOCALE]
+	for _, l := range locale.Locales() {
+		commandSetLocale.Completers = append(
+			commandSetLocale.Completers,
+			readline.PcItem(string(l)),
+		)
+	}
 }
 
 // New は新しいREPLを構築し、返す。
@@ -351,7 +368,24 @@ func eval(r *REPL, c *Command, input string) {
 		fmt.Fprint(r.out, SECRET_HEADER)
 	}
 
-	fmt.Fprintln(r.out, result.Message())
+	fmt.Fprint(r.out, result.Message())
+	fmt.Fprintln(r.out, formatSuccessCheckResult(result.SuccessCheckResult))
+}
+
+// formatSuccessCheckResult は、成功判定結果を色付きで整形する。
+// 成功判定を行わなかった場合は空文字列を返す。
+func formatSuccessCheckResult(t command.SuccessCheckResultType) string {
+	var color string
+	switch {
+	case t.IsSuccess():
+		color = ESC_CYAN
+	case t.IsFailure():
+		color = ESC_RED
+	default:
+		return ""
+	}
+
+	return " " + color + "[" + t.String() + "]" + ESC_RESET
 }
 
 var rollDiceRe = regexp.MustCompile(`\A(\d+)\s+(\d+)\z`)
@@ -411,6 +445,25 @@ func listGames(r *REPL, c *Command, input string) {
 	}
 }
 
+// setLocale は結果のメッセージの言語を設定する。
+func setLocale(r *REPL, c *Command, input string) {
+	if input == "" {
+		// 言語が指定されていなかった場合、現在の言語を出力する
+		fmt.Fprintln(r.out, r.bcDice.Locale())
+		return
+	}
+
+	l, err := locale.Parse(input)
+	if err != nil {
+		r.printError(err)
+		return
+	}
+
+	r.bcDice.SetLocale(l)
+
+	r.printOK()
+}
+
 // setDieFeeder は、ダイス供給機を設定する。
 // inputには以下を指定できる。
 //
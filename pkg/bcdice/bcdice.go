@@ -1,17 +1,23 @@
 package bcdice
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
+	"strings"
 
-	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/command"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/parser"
 	"github.com/raa0121/GoBCDice/pkg/core/util"
 	"github.com/raa0121/GoBCDice/pkg/dicebot"
+	_ "github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/all"
 	dicebotlist "github.com/raa0121/GoBCDice/pkg/dicebot/list"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/table"
 )
 
 // BCDiceの全体動作を統括する構造体。
@@ -20,11 +26,25 @@ type BCDice struct {
 	DiceBot    dicebot.DiceBot
 	dieFeeder  feeder.DieFeeder
 	diceRoller *roller.DiceRoller
+	// 利用者が追加した表
+	extraTables []*table.Table
+	// 結果のメッセージの言語
+	locale locale.Locale
+	// コマンドの実行に使用する資源の上限
+	limits limits.Limits
+	// 変数とマクロを保持するスコープ
+	scope *evaluator.Scope
+	// 実行中の繰り返しコマンドで、既に振られたダイスの数
+	priorRolledDice int
 }
 
 // New は新しいBCDiceを構築する。
 func New(f feeder.DieFeeder) *BCDice {
-	b := &BCDice{}
+	b := &BCDice{
+		locale: locale.DEFAULT,
+		limits: limits.Default(),
+		scope:  evaluator.NewScope(),
+	}
 
 	b.SetDieFeeder(f)
 	b.SetDiceBotByGameID("DiceBot")
@@ -53,49 +73,225 @@ func (b *BCDice) DieFeeder() feeder.DieFeeder {
 // 合わせてダイスローラーも設定される。
 func (b *BCDice) SetDieFeeder(f feeder.DieFeeder) {
 	b.dieFeeder = f
-	b.diceRoller = roller.New(f)
+	b.diceRoller = roller.NewWithLimits(f, b.limits)
+}
+
+// Locale は結果のメッセージの言語を返す。
+func (b *BCDice) Locale() locale.Locale {
+	return b.locale
+}
+
+// SetLocale は結果のメッセージの言語を設定する。
+func (b *BCDice) SetLocale(l locale.Locale) {
+	b.locale = l
+}
+
+// Limits はコマンドの実行に使用する資源の上限を返す。
+func (b *BCDice) Limits() limits.Limits {
+	return b.limits
+}
+
+// SetLimits はコマンドの実行に使用する資源の上限を設定する。
+// 合わせてダイスローラーとスコープの上限も設定される。
+func (b *BCDice) SetLimits(l limits.Limits) {
+	b.limits = l
+	b.diceRoller.SetLimits(l)
+	b.scope.SetMaxVariables(l.MaxVariables)
+}
+
+// Scope は変数とマクロを保持するスコープを返す。
+//
+// スコープは ExecuteCommand の呼び出しをまたいで保持される。
+func (b *BCDice) Scope() *evaluator.Scope {
+	return b.scope
+}
+
+// SetScope は変数とマクロを保持するスコープを設定する。
+// 複数のBCDiceで同じスコープを設定すると、変数とマクロを共有することができる。
+//
+// スコープに定義できる変数とマクロの数の上限は、設定されている上限に合わせられる。
+func (b *BCDice) SetScope(s *evaluator.Scope) {
+	s.SetMaxVariables(b.limits.MaxVariables)
+	b.scope = s
+}
+
+// LoadExtraTables は、ディレクトリ内の表ファイルを読み込み、利用者が追加した表として設定する。
+// 各表は、ファイル名から拡張子を除いたコマンドで呼び出すことができる。
+//
+// 読み込みに失敗した場合、設定されている表は変更されない。
+func (b *BCDice) LoadExtraTables(dir string) error {
+	tables, err := table.LoadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	b.extraTables = tables
+
+	return nil
+}
+
+// ExtraTables は利用者が追加した表を返す。
+func (b *BCDice) ExtraTables() []*table.Table {
+	return b.extraTables
 }
 
 // 空白で区切られた入力文字列から最初の部分を取り出すための正規表現
 var commandFirstPartRe = regexp.MustCompile(`\A([^\s]*)(\s.*)?`)
 
+// 変数への代入またはマクロ定義の可能性がある入力にマッチする正規表現
+var definitionRe = regexp.MustCompile(`\A[A-Za-z_][A-Za-z0-9_]*:?=`)
+
 // ExecuteCommand は指定されたコマンドを実行する。
+//
+// 入力は、ダイスボットの表、ダイスボット固有のコマンド、利用者が追加した表、基本コマンドの順に解釈する。
+// ある解釈でコマンドとして認識された場合は、その実行結果またはエラーをそのまま返す。
+// どの解釈でも認識されなかった場合は *NoMatchingCommandError を返す。
+// ただし、入力がどのコマンドの接頭辞にも一致しない場合は、構文解析を行わずに ErrNotCommand を返す。
+//
+// 入力中のマクロ参照 {name} は、解釈の前にスコープに定義されているマクロの本体に展開する。
+// 変数への代入とマクロ定義はシークレットロールとして扱わない。
+// そのため "Satk=2D6" は、変数 "atk" へのシークレットな代入ではなく、変数 "Satk" への代入となる。
+// 変数とマクロの合計数が上限を超える場合は *limits.ExceededError を返す。
+//
+// "x3 2D6" のような繰り返しコマンドでは、コマンドを指定された回数実行し、
+// 各回の結果をまとめた実行結果を返す。繰り返し回数が上限を超える場合は *limits.ExceededError を返す。
+//
+// 入力の長さやダイスの数などが上限を超えた場合は *limits.ExceededError を返す。
 func (b *BCDice) ExecuteCommand(input string) (*command.Result, error) {
-	command, isSecret := util.CheckIfInputMayBeASecretRoll(input)
+	if err := b.limits.CheckInputLength(input); err != nil {
+		return nil, err
+	}
+
+	input = b.scope.ExpandMacros(input)
+	if err := b.limits.CheckInputLength(input); err != nil {
+		return nil, err
+	}
+
+	if !b.MayBeCommand(input) {
+		return nil, ErrNotCommand
+	}
+
+	c, isSecret := util.CheckIfInputMayBeASecretRoll(input)
+	if isSecret && definitionRe.MatchString(c) && definitionRe.MatchString(input) {
+		// "sanc:=..." などを "anc" の定義と解釈しないように、
+		// 変数への代入とマクロ定義はシークレットロールとして扱わない
+		c, isSecret = input, false
+	}
 
-	separated := commandFirstPartRe.FindStringSubmatch(command)
+	result, err := b.executeRepeatableCommand(c)
+	if err != nil {
+		return nil, err
+	}
+
+	result.IsSecret = isSecret
+	for _, r := range result.Results {
+		r.IsSecret = isSecret
+	}
+
+	return result, nil
+}
+
+// executeCommand は、入力cを順に解釈してコマンドを実行する。
+func (b *BCDice) executeCommand(c string) (*command.Result, error) {
+	separated := commandFirstPartRe.FindStringSubmatch(c)
 	firstPart := separated[1]
 
-	{
-		result, err := b.ExecuteDiceBotCommand(firstPart)
-		if err == nil {
-			result.IsSecret = isSecret
-			return result, nil
-		}
+	executors := []func() (*command.Result, error){
+		func() (*command.Result, error) { return b.ExecuteTableCommand(firstPart) },
+		func() (*command.Result, error) { return b.ExecuteDiceBotCommand(firstPart) },
+		func() (*command.Result, error) { return b.ExecuteExtraTableCommand(firstPart) },
+		func() (*command.Result, error) { return b.ExecuteBasicCommand(c) },
+		func() (*command.Result, error) { return b.ExecuteBasicCommand(firstPart) },
 	}
 
-	{
-		result, err := b.ExecuteBasicCommand(command)
+	var lastErr error
+	for _, execute := range executors {
+		result, err := execute()
 		if err == nil {
-			result.IsSecret = isSecret
 			return result, nil
 		}
-	}
-	{
-		result, err := b.ExecuteBasicCommand(firstPart)
-		if err == nil {
-			result.IsSecret = isSecret
-			return result, nil
+
+		if !isUnmatched(err) {
+			// コマンドとして認識されたが、実行に失敗した
+			return nil, err
 		}
 
+		lastErr = err
+	}
+
+	return nil, &NoMatchingCommandError{
+		Input: c,
+		Err:   lastErr,
+	}
+}
+
+// ExecuteCommandLines は、複数行の入力の各行のコマンドを順に実行する。
+//
+// すべての行で同じダイス供給機を使用する。
+// 返り値の結果のスライスの要素は入力の各行に対応し、実行に失敗した行の要素はnilとなる。
+// すべての行で実行に失敗した場合は、最初の行のエラーを返す。
+// 入力全体の長さが上限を超えた場合は、どの行も実行せずにエラーを返す。
+func (b *BCDice) ExecuteCommandLines(input string) ([]*command.Result, error) {
+	if err := b.limits.CheckInputLength(input); err != nil {
 		return nil, err
 	}
+
+	lines := strings.Split(strings.TrimRight(input, "\n"), "\n")
+	results := make([]*command.Result, 0, len(lines))
+
+	var firstErr error
+	succeeded := false
+
+	for _, line := range lines {
+		result, err := b.ExecuteCommand(strings.TrimRight(line, "\r"))
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+
+			results = append(results, nil)
+			continue
+		}
+
+		succeeded = true
+		results = append(results, result)
+	}
+
+	if !succeeded {
+		return nil, firstErr
+	}
+
+	return results, nil
+}
+
+// ExecuteTableCommand は、設定されているダイスボットの表のうち、
+// 指定されたコマンドで呼び出すものを振る。
+func (b *BCDice) ExecuteTableCommand(c string) (*command.Result, error) {
+	t, found := b.DiceBot.FindTable(c)
+	if !found {
+		return nil, fmt.Errorf("%w: table not found: %s", dicebot.ErrUnknownCommand, c)
+	}
+
+	return t.Roll(b.DiceBot.GameID(), b.newEvaluator())
+}
+
+// ExecuteExtraTableCommand は、利用者が追加した表のうち、
+// 指定されたコマンドで呼び出すものを振る。
+//
+// コマンドの大文字と小文字は区別しない。
+func (b *BCDice) ExecuteExtraTableCommand(c string) (*command.Result, error) {
+	for _, t := range b.extraTables {
+		if strings.EqualFold(t.Command, c) {
+			return t.Roll(b.DiceBot.GameID(), b.newEvaluator())
+		}
+	}
+
+	return nil, fmt.Errorf("%w: extra table not found: %s", dicebot.ErrUnknownCommand, c)
 }
 
 // ExecuteDiceBotCommand は設定されているダイスボットを使用して指定されたコマンドを実行する。
 func (b *BCDice) ExecuteDiceBotCommand(c string) (*command.Result, error) {
-	env := evaluator.NewEnvironment()
-	ev := evaluator.NewEvaluator(b.diceRoller, env)
+	ev := b.newEvaluator()
 
 	result, err := b.DiceBot.ExecuteCommand(c, ev)
 	if err != nil {
@@ -107,13 +303,38 @@ func (b *BCDice) ExecuteDiceBotCommand(c string) (*command.Result, error) {
 
 // ExecuteBasicCommand はBCDiceの基本コマンドを実行する。
 func (b *BCDice) ExecuteBasicCommand(c string) (*command.Result, error) {
-	node, parseErr := parser.Parse("input", []byte(c))
+	node, parseErr := parser.ParseWithLimits("input", []byte(c), b.limits)
 	if parseErr != nil {
 		return nil, parseErr
 	}
 
-	env := evaluator.NewEnvironment()
+	ev := b.newEvaluator()
+
+	result, err := command.Execute(node, b.DiceBot.GameID(), ev)
+	if err != nil {
+		return nil, err
+	}
+
+	// ゲームシステムに合わせて成功判定結果を調整する
+	b.DiceBot.AdjustSuccessCheckResult(result, ev)
+
+	return result, nil
+}
+
+// newEvaluator は、設定されているダイスボットに合わせた新しい評価器を返す。
+func (b *BCDice) newEvaluator() *evaluator.Evaluator {
+	env := evaluator.NewEnvironmentWithScope(b.scope)
 	ev := evaluator.NewEvaluator(b.diceRoller, env)
+	ev.DefaultD66Order = b.DiceBot.D66Order()
+	ev.Locale = b.locale
+	ev.Limits = b.limits
+	ev.PriorRolledDice = b.priorRolledDice
+
+	return ev
+}
 
-	return command.Execute(node.(ast.Node), b.DiceBot.GameID(), ev)
+// isUnmatched は、errが入力をコマンドとして認識しなかったことを表すエラーかを返す。
+func isUnmatched(err error) bool {
+	var syntaxErr *parser.SyntaxError
+	return errors.Is(err, dicebot.ErrUnknownCommand) || errors.As(err, &syntaxErr)
 }
@@ -1,7 +1,11 @@
 package bcdice
 
 import (
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/dicebot"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/basic"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/table"
 	"testing"
 )
 
@@ -25,3 +29,136 @@ func TestSetUnknownDiceBot(t *testing.T) {
 		t.Fatal("未知のダイスボットを設定できてしまった")
 	}
 }
+
+func TestExecuteTableCommand(t *testing.T) {
+	f := feeder.NewQueue([]dice.Die{{3, 6}, {4, 6}})
+	b := New(f)
+
+	b.DiceBot = &dicebot.DiceBotImpl{
+		BasicInfo: basic.BasicInfo(),
+		Tables: []*table.Table{
+			table.New(
+				"ET",
+				"遭遇表",
+				table.SumDice(2, 6),
+				[]table.Item{
+					{Min: 2, Max: 6, Text: "ゴブリン"},
+					{Min: 7, Max: 12, Text: "ドラゴン"},
+				},
+			),
+		},
+	}
+
+	r, err := b.ExecuteCommand("Set 遭遇")
+	if err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	expected := "DiceBot : 遭遇表(2D6) ＞ 7 ＞ ドラゴン"
+	actual := r.Message()
+	if actual != expected {
+		t.Errorf("got: %q, want: %q", actual, expected)
+	}
+
+	if !r.IsSecret {
+		t.Error("シークレットロールになっていない")
+	}
+}
+
+func TestRecordedSessionShouldBeReplayable(t *testing.T) {
+	commands := []string{
+		"2D6+1",
+		"3B6>=4",
+		"2R6>=5",
+		"CHOICE[A,B,C]",
+		"D66",
+	}
+
+	recorder := feeder.NewRecorder(feeder.NewMT19937(20190401))
+	recording := New(recorder)
+
+	expectedMessages := []string{}
+	for _, c := range commands {
+		r, err := recording.ExecuteCommand(c)
+		if err != nil {
+			t.Fatalf("コマンド実行エラー: %s", err)
+		}
+
+		expectedMessages = append(expectedMessages, r.Message())
+	}
+
+	q, parseErr := feeder.NewReplayQueue(recorder.String())
+	if parseErr != nil {
+		t.Fatalf("記録の読み込みエラー: %s", parseErr)
+	}
+
+	replaying := New(q)
+	for i, c := range commands {
+		r, err := replaying.ExecuteCommand(c)
+		if err != nil {
+			t.Fatalf("コマンド実行エラー: %s", err)
+		}
+
+		if actual := r.Message(); actual != expectedMessages[i] {
+			t.Errorf("%q: got: %q, want: %q", c, actual, expectedMessages[i])
+		}
+	}
+
+	if !q.IsEmpty() {
+		t.Error("ダイス残り: " + dice.FormatDice(q.Dice()))
+	}
+}
+
+func TestExecuteCommandLines(t *testing.T) {
+	f := feeder.NewQueue([]dice.Die{{3, 6}, {4, 6}, {2, 6}, {5, 6}, {1, 6}})
+	b := New(f)
+
+	results, err := b.ExecuteCommandLines("2D6\n不明なコマンド\r\nS2B6\n")
+	if err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("結果の数が異なる: got %d, want 3", len(results))
+	}
+
+	expectedMessages := []string{
+		"DiceBot : (2D6) ＞ 7[3,4] ＞ 7",
+		"",
+		"DiceBot : (2B6) ＞ 2,5",
+	}
+	for i, expected := range expectedMessages {
+		if expected == "" {
+			if results[i] != nil {
+				t.Errorf("#%d: 結果がnilでない: %q", i, results[i].Message())
+			}
+
+			continue
+		}
+
+		if results[i] == nil {
+			t.Errorf("#%d: 結果がnil", i)
+			continue
+		}
+
+		if actual := results[i].Message(); actual != expected {
+			t.Errorf("#%d: got: %q, want: %q", i, actual, expected)
+		}
+	}
+
+	if !results[2].IsSecret {
+		t.Error("シークレットロールになっていない")
+	}
+
+	if f.Remaining() != 1 {
+		t.Errorf("ダイス残り数が異なる: got %d, want 1", f.Remaining())
+	}
+}
+
+func TestExecuteCommandLines_AllLinesFailed(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	if _, err := b.ExecuteCommandLines("不明なコマンド\n別の不明なコマンド"); err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+}
@@ -0,0 +1,186 @@
+package bcdice
+
+import (
+	"sync"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+	dicebotlist "github.com/raa0121/GoBCDice/pkg/dicebot/list"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/table"
+)
+
+// 複数のゴルーチンから同時にコマンドを実行できるBCDiceの構造体。
+//
+// BCDiceとは異なり、ダイスボットを設定として保持せず、
+// コマンドを実行するたびにゲーム識別子を指定する。
+// 実行のたびに新しいダイスボットと評価器を構築するため、実行同士が状態を共有しない。
+// ダイス供給機は排他制御を行うものに包んで共有する。
+type Engine struct {
+	// 排他制御を行うダイス供給機
+	dieFeeder *feeder.Synchronized
+
+	// 利用者が追加した表と設定を保護するためのミューテックス
+	mu sync.RWMutex
+	// 利用者が追加した表
+	extraTables []*table.Table
+	// 結果のメッセージの言語
+	locale locale.Locale
+	// コマンドの実行に使用する資源の上限
+	limits limits.Limits
+}
+
+// NewEngine は、ダイス供給機fを使用する新しいEngineを構築する。
+//
+// fは排他制御を行うダイス供給機に包まれる。
+func NewEngine(f feeder.DieFeeder) *Engine {
+	sf := feeder.NewSynchronized(f)
+
+	return &Engine{
+		dieFeeder:   sf,
+		extraTables: []*table.Table{},
+		locale:      locale.DEFAULT,
+		limits:      limits.Default(),
+	}
+}
+
+// DieFeeder は設定されているダイス供給機を返す。
+func (e *Engine) DieFeeder() feeder.DieFeeder {
+	return e.dieFeeder
+}
+
+// LoadExtraTables は、ディレクトリ内の表ファイルを読み込み、利用者が追加した表として設定する。
+// 各表は、ファイル名から拡張子を除いたコマンドで呼び出すことができる。
+//
+// 読み込みに失敗した場合、設定されている表は変更されない。
+// 実行中のコマンドがあっても安全に呼び出すことができる。
+func (e *Engine) LoadExtraTables(dir string) error {
+	tables, err := table.LoadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.extraTables = tables
+
+	return nil
+}
+
+// ExtraTables は利用者が追加した表を返す。
+func (e *Engine) ExtraTables() []*table.Table {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.extraTables
+}
+
+// Locale は結果のメッセージの言語を返す。
+func (e *Engine) Locale() locale.Locale {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.locale
+}
+
+// SetLocale は結果のメッセージの言語を設定する。
+// 実行中のコマンドがあっても安全に呼び出すことができる。
+func (e *Engine) SetLocale(l locale.Locale) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.locale = l
+}
+
+// Limits はコマンドの実行に使用する資源の上限を返す。
+func (e *Engine) Limits() limits.Limits {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.limits
+}
+
+// SetLimits はコマンドの実行に使用する資源の上限を設定する。
+// 実行中のコマンドがあっても安全に呼び出すことができる。
+func (e *Engine) SetLimits(l limits.Limits) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.limits = l
+}
+
+// ExecuteCommand は、gameIDで指定されたゲームシステムのダイスボットを使用して、
+// 指定されたコマンドを実行する。
+func (e *Engine) ExecuteCommand(gameID string, input string) (*command.Result, error) {
+	b, err := e.newSession(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.ExecuteCommand(input)
+}
+
+// ExecuteCommandInScope は、gameIDで指定されたゲームシステムのダイスボットを使用して、
+// 指定されたコマンドを実行する。
+//
+// 変数とマクロはスコープscopeのものを使用する。
+// 同じスコープを指定して実行を繰り返すことで、変数とマクロを実行間で引き継ぐことができる。
+func (e *Engine) ExecuteCommandInScope(
+	gameID string,
+	input string,
+	scope *evaluator.Scope,
+) (*command.Result, error) {
+	b, err := e.newSession(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	b.SetScope(scope)
+
+	return b.ExecuteCommand(input)
+}
+
+// ExecuteCommandLines は、gameIDで指定されたゲームシステムのダイスボットを使用して、
+// 複数行の入力の各行のコマンドを順に実行する。
+//
+// 返り値の扱いは BCDice.ExecuteCommandLines と同じ。
+func (e *Engine) ExecuteCommandLines(gameID string, input string) ([]*command.Result, error) {
+	b, err := e.newSession(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.ExecuteCommandLines(input)
+}
+
+// newSession は、1回の実行のみで使用するBCDiceを構築する。
+//
+// ダイスボットは新しく構築し、ダイス供給機と利用者が追加した表は共有する。
+// 言語と上限は構築時に設定されているものを使用する。
+// 変数とマクロのスコープは新しく構築する。
+func (e *Engine) newSession(gameID string) (*BCDice, error) {
+	diceBotConstructor, err := dicebotlist.Find(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	scope := evaluator.NewScope()
+	scope.SetMaxVariables(e.limits.MaxVariables)
+
+	return &BCDice{
+		DiceBot:     diceBotConstructor(),
+		dieFeeder:   e.dieFeeder,
+		diceRoller:  roller.NewWithLimits(e.dieFeeder, e.limits),
+		extraTables: e.extraTables,
+		locale:      e.locale,
+		limits:      e.limits,
+		scope:       scope,
+	}, nil
+}
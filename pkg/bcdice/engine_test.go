@@ -0,0 +1,251 @@
+package bcdice_test
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/bcdice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+)
+
+func TestEngine_ExecuteCommand(t *testing.T) {
+	testcases := []struct {
+		gameID   string
+		input    string
+		dice     []dice.Die
+		expected string
+	}{
+		{"DiceBot", "2D6", []dice.Die{{3, 6}, {5, 6}}, "DiceBot : (2D6) ＞ 8[3,5] ＞ 8"},
+		{"Cthulhu", "CC<=50", []dice.Die{{35, 100}}, "Cthulhu : (1D100<=50) ＞ 35 ＞ 成功"},
+		{"CoC7th", "CC<=50", []dice.Die{{3, 10}, {3, 10}}, "Cthulhu7th : (1D100<=50) ボーナス・ペナルティダイス[0] ＞ 33 ＞ 33 ＞ レギュラー成功"},
+	}
+
+	for _, test := range testcases {
+		t.Run(fmt.Sprintf("%s/%s", test.gameID, test.input), func(t *testing.T) {
+			e := bcdice.NewEngine(feeder.NewQueue(test.dice))
+
+			result, err := e.ExecuteCommand(test.gameID, test.input)
+			if err != nil {
+				t.Fatalf("コマンド実行エラー: %s", err)
+			}
+
+			if actual := result.Message(); actual != test.expected {
+				t.Errorf("got: %q, want: %q", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestEngine_ExecuteCommand_UnknownGameID(t *testing.T) {
+	e := bcdice.NewEngine(feeder.NewQueue([]dice.Die{{1, 6}}))
+
+	if _, err := e.ExecuteCommand("Unknown", "1D6"); err == nil {
+		t.Error("エラーが発生しませんでした")
+	}
+}
+
+func TestEngine_SetLocale(t *testing.T) {
+	testcases := []struct {
+		locale   locale.Locale
+		gameID   string
+		input    string
+		dice     []dice.Die
+		expected string
+	}{
+		{locale.JA, "DiceBot", "2D6>=7", []dice.Die{{3, 6}, {5, 6}}, "DiceBot : (2D6>=7) ＞ 8[3,5] ＞ 8 ＞ 成功"},
+		{locale.EN, "DiceBot", "2D6>=7", []dice.Die{{3, 6}, {5, 6}}, "DiceBot : (2D6>=7) ＞ 8[3,5] ＞ 8 ＞ Success"},
+		{locale.KO, "DiceBot", "2D6>=7", []dice.Die{{3, 6}, {5, 6}}, "DiceBot : (2D6>=7) ＞ 8[3,5] ＞ 8 ＞ 성공"},
+		{locale.ZH, "DiceBot", "2D6>=9", []dice.Die{{3, 6}, {5, 6}}, "DiceBot : (2D6>=9) ＞ 8[3,5] ＞ 8 ＞ 失败"},
+		// ダイスボット固有のメッセージが登録されていない言語では英語になる
+		{locale.KO, "Cthulhu", "CC<=50", []dice.Die{{10, 100}}, "Cthulhu : (1D100<=50) ＞ 10 ＞ Special"},
+	}
+
+	for _, test := range testcases {
+		t.Run(fmt.Sprintf("%s/%s/%s", test.locale, test.gameID, test.input), func(t *testing.T) {
+			e := bcdice.NewEngine(feeder.NewQueue(test.dice))
+			e.SetLocale(test.locale)
+
+			result, err := e.ExecuteCommand(test.gameID, test.input)
+			if err != nil {
+				t.Fatalf("コマンド実行エラー: %s", err)
+			}
+
+			if actual := result.Message(); actual != test.expected {
+				t.Errorf("got: %q, want: %q", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestEngine_Limits(t *testing.T) {
+	l := limits.Default()
+	l.MaxTotalDice = 20
+
+	testcases := []struct {
+		input    string
+		expected limits.Kind
+	}{
+		{"99999999D99999999", limits.DICE_PER_ROLL},
+		{"1D99999999", limits.SIDES},
+		{"1D2147483647r>=1", limits.SIDES},
+		{"1D2147483647!", limits.SIDES},
+		{"10D6+11D6", limits.TOTAL_DICE},
+		{"C(" + strings.Repeat("1+(", 150) + "1" + strings.Repeat(")", 150) + ")", limits.DEPTH},
+		{"C(" + strings.Repeat("1+", 600) + "1)", limits.INPUT_LENGTH},
+		{"2D6 " + strings.Repeat("#", 1000), limits.INPUT_LENGTH},
+	}
+
+	for i, test := range testcases {
+		t.Run(fmt.Sprintf("%d/%s", i, test.expected), func(t *testing.T) {
+			e := bcdice.NewEngine(feeder.NewMT19937(1))
+			e.SetLimits(l)
+
+			_, err := e.ExecuteCommand("DiceBot", test.input)
+
+			var exceeded *limits.ExceededError
+			if !errors.As(err, &exceeded) {
+				t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+			}
+
+			if exceeded.Kind != test.expected {
+				t.Errorf("got: %s, want: %s", exceeded.Kind, test.expected)
+			}
+		})
+	}
+}
+
+func TestEngine_ExecuteCommandLines(t *testing.T) {
+	e := bcdice.NewEngine(feeder.NewQueue([]dice.Die{{1, 6}, {2, 6}}))
+
+	results, err := e.ExecuteCommandLines("DiceBot", "1D6\n1D6")
+	if err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("結果の数が異なる: got %d, want %d", len(results), 2)
+	}
+}
+
+// 複数のゴルーチンから同時にコマンドを実行しても、ダイスが失われたり重複したりしないことを確認する。
+// go test -race で実行すること。
+func TestEngine_ExecuteCommandConcurrently(t *testing.T) {
+	const numOfGoroutines = 32
+	const numOfCommandsPerGoroutine = 50
+
+	ds := make([]dice.Die, 0, numOfGoroutines*numOfCommandsPerGoroutine*2)
+	for i := 0; i < numOfGoroutines*numOfCommandsPerGoroutine; i++ {
+		ds = append(ds, dice.Die{1, 6}, dice.Die{2, 6})
+	}
+
+	e := bcdice.NewEngine(feeder.NewQueue(ds))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, numOfGoroutines)
+
+	for i := 0; i < numOfGoroutines; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < numOfCommandsPerGoroutine; j++ {
+				result, err := e.ExecuteCommand("DiceBot", "1D6+1D6")
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				if len(result.RolledDice) != 2 {
+					errs <- fmt.Errorf("振ったダイスの数が異なる: %v", result.RolledDice)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	if _, err := e.DieFeeder().Next(6); err == nil {
+		t.Error("すべてのダイスが使われていない")
+	}
+}
+
+// ゲームシステムの異なるコマンドと表の再読み込みを同時に行っても、競合しないことを確認する。
+// go test -race で実行すること。
+func TestEngine_MixedGameSystemsConcurrently(t *testing.T) {
+	e := bcdice.NewEngine(feeder.NewMT19937(1))
+	extraTablesDir := filepath.Join("testdata", "extratables")
+	if err := e.LoadExtraTables(extraTablesDir); err != nil {
+		t.Fatal(err)
+	}
+
+	commands := []struct {
+		gameID string
+		input  string
+	}{
+		{"DiceBot", "2D6>=7"},
+		{"DiceBot", "ET"},
+		{"Cthulhu", "CCB<=60"},
+		{"Cthulhu7th", "CC(1)<=60"},
+		{"SwordWorld2.5", "K20+5@9"},
+		{"SW2.0", "GR3"},
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(commands)*8+1)
+
+	for i := 0; i < 8; i++ {
+		for _, c := range commands {
+			wg.Add(1)
+
+			go func(gameID string, input string) {
+				defer wg.Done()
+
+				for j := 0; j < 20; j++ {
+					result, err := e.ExecuteCommand(gameID, input)
+					if err != nil {
+						errs <- fmt.Errorf("%s %s: %s", gameID, input, err)
+						return
+					}
+
+					if result.GameID == "" {
+						errs <- fmt.Errorf("%s %s: ゲーム識別子が設定されていない", gameID, input)
+						return
+					}
+				}
+			}(c.gameID, c.input)
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for j := 0; j < 20; j++ {
+			if err := e.LoadExtraTables(extraTablesDir); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
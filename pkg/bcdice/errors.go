@@ -0,0 +1,43 @@
+package bcdice
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotCommand は、入力がコマンドでないことを表すエラー。
+//
+// 入力が基本コマンド、ダイスボット、利用者が追加した表のいずれの接頭辞にも一致しない場合、
+// 構文解析を行わずにこのエラーを返す。チャットの通常の発言は、このエラーで区別できる。
+var ErrNotCommand = errors.New("not a command")
+
+// ErrInvalidRepeatCount は、繰り返しコマンドの繰り返し回数が1未満であることを表すエラー。
+var ErrInvalidRepeatCount = errors.New("repeat count must be 1 or more")
+
+// NoMatchingCommandError は、入力がどのコマンドとしても認識されなかったことを表すエラー。
+//
+// 最後に試した解釈（基本コマンドの構文解析）で発生したエラーをラップする。
+// そのため、errors.As で *parser.SyntaxError を取り出して構文エラーの位置を調べることができる。
+type NoMatchingCommandError struct {
+	// 入力されたコマンド
+	Input string
+	// 最後に試した解釈で発生したエラー
+	Err error
+}
+
+// NoMatchingCommandError がerrorを実装していることの確認。
+var _ error = (*NoMatchingCommandError)(nil)
+
+// Error はエラーメッセージを返す。
+func (e *NoMatchingCommandError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("no matching command: %s", e.Input)
+	}
+
+	return fmt.Sprintf("no matching command: %s: %s", e.Input, e.Err)
+}
+
+// Unwrap は最後に試した解釈で発生したエラーを返す。
+func (e *NoMatchingCommandError) Unwrap() error {
+	return e.Err
+}
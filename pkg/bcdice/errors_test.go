@@ -0,0 +1,115 @@
+package bcdice
+
+import (
+	"errors"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+	"github.com/raa0121/GoBCDice/pkg/core/parser"
+	"github.com/raa0121/GoBCDice/pkg/dicebot"
+	"strings"
+	"testing"
+)
+
+func TestExecuteCommand_NoMatchingCommandError(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	_, err := b.ExecuteCommand("2D6+")
+	if err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+
+	var noMatch *NoMatchingCommandError
+	if !errors.As(err, &noMatch) {
+		t.Fatalf("*NoMatchingCommandError ではありません: %v", err)
+	}
+
+	if noMatch.Input != "2D6+" {
+		t.Errorf("Input: got: %q, want: %q", noMatch.Input, "2D6+")
+	}
+
+	if errors.Is(err, ErrNotCommand) {
+		t.Errorf("ErrNotCommand になっています: %v", err)
+	}
+
+	var syntaxErr *parser.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("*parser.SyntaxError をラップしていません: %v", err)
+	}
+
+	if syntaxErr.Line != 1 || syntaxErr.Col != 5 || syntaxErr.Offset != 4 {
+		t.Errorf("位置: got: %d:%d (%d), want: 1:5 (4)",
+			syntaxErr.Line, syntaxErr.Col, syntaxErr.Offset)
+	}
+}
+
+func TestExecuteCommand_FeederExhausted(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	_, err := b.ExecuteCommand("2D6")
+	if err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+
+	if !errors.Is(err, feeder.ErrExhausted) {
+		t.Errorf("feeder.ErrExhausted ではありません: %v", err)
+	}
+
+	var noMatch *NoMatchingCommandError
+	if errors.As(err, &noMatch) {
+		t.Errorf("*NoMatchingCommandError になっています: %v", err)
+	}
+}
+
+func TestExecuteCommand_LimitExceeded(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	_, err := b.ExecuteCommand("99999D6")
+	if err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+
+	var exceeded *limits.ExceededError
+	if !errors.As(err, &exceeded) {
+		t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+	}
+
+	if exceeded.Kind != limits.DICE_PER_ROLL {
+		t.Errorf("Kind: got: %s, want: %s", exceeded.Kind, limits.DICE_PER_ROLL)
+	}
+}
+
+func TestExecuteCommand_DiceBotError(t *testing.T) {
+	b := New(feeder.NewQueue([]dice.Die{{3, 10}, {3, 10}}))
+	if err := b.SetDiceBotByGameID("Cthulhu7th"); err != nil {
+		t.Fatalf("ダイスボット設定エラー: %s", err)
+	}
+
+	_, err := b.ExecuteCommand("CC(3)<=50")
+	if err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+
+	// ダイスボットのエラーが、基本コマンドの構文エラーで上書きされてはならない
+	if !strings.Contains(err.Error(), "bonus/penalty dice out of range") {
+		t.Errorf("ダイスボットのエラーではありません: %v", err)
+	}
+
+	if errors.Is(err, dicebot.ErrUnknownCommand) {
+		t.Errorf("dicebot.ErrUnknownCommand になっています: %v", err)
+	}
+
+	var noMatch *NoMatchingCommandError
+	if errors.As(err, &noMatch) {
+		t.Errorf("*NoMatchingCommandError になっています: %v", err)
+	}
+}
+
+func TestExecuteTableCommand_NotFound(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	_, err := b.ExecuteTableCommand("XYZ")
+	if !errors.Is(err, dicebot.ErrUnknownCommand) {
+		t.Errorf("dicebot.ErrUnknownCommand ではありません: %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package bcdice_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/bcdice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/table"
+	dicebottesting "github.com/raa0121/GoBCDice/pkg/dicebot/testing"
+)
+
+func TestExtraTables(t *testing.T) {
+	setup := func(b *bcdice.BCDice) error {
+		return b.LoadExtraTables(filepath.Join("testdata", "extratables"))
+	}
+
+	testDataFiles := dicebottesting.JoinWithTestData([]string{"extra_tables.txt"})
+	dicebottesting.RunWithSetup("DiceBot", t, setup, testDataFiles...)
+}
+
+func TestLoadExtraTables_ParseError(t *testing.T) {
+	b := bcdice.New(feeder.NewEmptyQueue())
+
+	err := b.LoadExtraTables(filepath.Join("testdata", "broken"))
+	if err == nil {
+		t.Fatal("エラーが発生しなかった")
+	}
+
+	var parseErr *table.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("構文エラーではない: %s", err)
+	}
+
+	if parseErr.Line != 4 {
+		t.Errorf("行番号が異なる: got %d, want %d", parseErr.Line, 4)
+	}
+
+	if len(b.ExtraTables()) != 0 {
+		t.Error("表が設定されてしまった")
+	}
+}
@@ -0,0 +1,60 @@
+package bcdice
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/raa0121/GoBCDice/pkg/core/parser"
+)
+
+// 接頭辞のパターンを連結した文字列と、それから構築した正規表現との対応
+var prefixRegexps sync.Map
+
+// MayBeCommand は、入力がコマンドである可能性があるかを返す。
+//
+// 構文解析は行わず、基本コマンド、繰り返しコマンド、設定されているダイスボット、利用者が追加した表の
+// 接頭辞のパターンと入力の先頭とを、大文字と小文字を区別せずに照合する。
+// シークレットロールのマーク "S" は取り除いてから照合する。
+//
+// ダイスボットの接頭辞のパターンが不正な場合は、コマンドである可能性があるものとする。
+func (b *BCDice) MayBeCommand(input string) bool {
+	re, err := b.prefixRegexp()
+	if err != nil {
+		return true
+	}
+
+	return re.MatchString(input)
+}
+
+// prefixRegexp は、コマンドの接頭辞と照合する正規表現を返す。
+//
+// 構築した正規表現はパターンごとにキャッシュする。
+func (b *BCDice) prefixRegexp() (*regexp.Regexp, error) {
+	prefixes := parser.BasicCommandPrefixes()
+	prefixes = append(prefixes, repeatPrefixes...)
+	prefixes = append(prefixes, b.DiceBot.Prefixes()...)
+
+	for _, t := range b.extraTables {
+		prefixes = append(prefixes, regexp.QuoteMeta(t.Command))
+	}
+
+	key := strings.Join(prefixes, "\n")
+	if re, ok := prefixRegexps.Load(key); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	alternatives := make([]string, 0, len(prefixes))
+	for _, p := range prefixes {
+		alternatives = append(alternatives, "(?:"+p+")")
+	}
+
+	re, err := regexp.Compile(`(?i)^S?(?:` + strings.Join(alternatives, "|") + `)`)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixRegexps.Store(key, re)
+
+	return re, nil
+}
@@ -0,0 +1,79 @@
+package bcdice
+
+import (
+	"errors"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/dicebot"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/basic"
+	"testing"
+)
+
+func TestMayBeCommand(t *testing.T) {
+	testcases := []struct {
+		gameID   string
+		input    string
+		expected bool
+	}{
+		{"DiceBot", "2D6", true},
+		{"DiceBot", "s2d6>=7", true},
+		{"DiceBot", "(1+2)D6", true},
+		{"DiceBot", "[1...3]D6", true},
+		{"DiceBot", "-1+2D6", true},
+		{"DiceBot", "C(1+2)", true},
+		{"DiceBot", "choice[a,b]", true},
+		{"DiceBot", "D66S", true},
+		{"DiceBot", "2D6+", true},
+		{"DiceBot", "こんにちは", false},
+		{"DiceBot", "hello 2D6", false},
+		{"DiceBot", "CC<=50", false},
+		{"DiceBot", "", false},
+		{"DiceBot", "{atk}+1D6", true},
+		{"DiceBot", "atk=2D6+5", true},
+		{"DiceBot", "sanc:=CC<=50", true},
+		{"DiceBot", "おはよう atk=1", false},
+		{"Cthulhu", "CC<=50", true},
+		{"Cthulhu", "CBR(50,20)", true},
+		{"Cthulhu", "RES(10-5)", true},
+		{"Cthulhu", "Cheese", false},
+		{"SwordWorld2.0", "K20+5", true},
+		{"SwordWorld2.0", "GR", true},
+		{"SwordWorld2.0", "Good morning", false},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.gameID+"/"+test.input, func(t *testing.T) {
+			b := New(feeder.NewEmptyQueue())
+			if err := b.SetDiceBotByGameID(test.gameID); err != nil {
+				t.Fatalf("ダイスボット設定エラー: %s", err)
+			}
+
+			actual := b.MayBeCommand(test.input)
+			if actual != test.expected {
+				t.Errorf("got: %t, want: %t", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestMayBeCommand_InvalidPrefix(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	info := *basic.BasicInfo()
+	info.Prefixes = []string{`(`}
+	b.DiceBot = &dicebot.DiceBotImpl{
+		BasicInfo: &info,
+	}
+
+	if !b.MayBeCommand("こんにちは") {
+		t.Error("不正な接頭辞のパターンで、コマンドでないと判定された")
+	}
+}
+
+func TestExecuteCommand_NotCommand(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	_, err := b.ExecuteCommand("こんにちは")
+	if !errors.Is(err, ErrNotCommand) {
+		t.Errorf("ErrNotCommand ではありません: %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package bcdice
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+)
+
+// 繰り返しコマンドにマッチする正規表現。
+//
+// "x3 2D6"、"rep3 2D6"、"repeat3 2D6"、"3#2D6" の形式に対応する。
+var repeatRe = regexp.MustCompile(`(?i)\A(?:(?:X|REP(?:EAT)?)([0-9]+)\s+|([0-9]+)#\s*)(\S.*)\z`)
+
+// 繰り返しコマンドの接頭辞のパターン（正規表現）
+var repeatPrefixes = []string{
+	`(?:X|REP(?:EAT)?)[0-9]+\s`,
+	`[0-9]+#`,
+}
+
+// parseRepeat は、入力cが繰り返しコマンドであれば、繰り返し回数と繰り返すコマンドを返す。
+// 繰り返しコマンドでない場合、3番目の返り値はfalseとなる。
+// 繰り返し回数が1未満であっても、繰り返しコマンドの形式であれば、その回数をそのまま返す。
+func parseRepeat(c string) (int, string, bool) {
+	m := repeatRe.FindStringSubmatch(c)
+	if m == nil {
+		return 0, "", false
+	}
+
+	countStr := m[1]
+	if countStr == "" {
+		countStr = m[2]
+	}
+
+	count, err := strconv.Atoi(countStr)
+	if err != nil {
+		// 大きすぎて変換できない回数は、上限の確認で必ずエラーとなるようにする
+		count = int(^uint(0) >> 1)
+	}
+
+	return count, m[3], true
+}
+
+// executeRepeatableCommand は、入力cが繰り返しコマンドであれば、
+// 繰り返すコマンドを指定された回数実行し、各回の結果をまとめて返す。
+// 繰り返しコマンドでない場合は、入力cをそのまま実行する。
+//
+// 繰り返し回数が1未満の場合は ErrInvalidRepeatCount を、
+// 上限を超える場合は *limits.ExceededError を返す。
+// 振るダイスの合計数の上限は、各回ではなく繰り返し全体に対して適用される。
+// いずれかの回で実行に失敗した場合は、そのエラーを返す。
+func (b *BCDice) executeRepeatableCommand(c string) (*command.Result, error) {
+	count, inner, isRepeat := parseRepeat(c)
+	if !isRepeat {
+		return b.executeCommand(c)
+	}
+
+	if count < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidRepeatCount, count)
+	}
+
+	if err := b.limits.CheckRepeats(count); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		b.priorRolledDice = 0
+	}()
+
+	results := make([]*command.Result, 0, count)
+	for i := 0; i < count; i++ {
+		result, err := b.executeCommand(inner)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+		b.priorRolledDice += len(result.RolledDice)
+	}
+
+	return command.NewRepeatResult(b.DiceBot.GameID(), results), nil
+}
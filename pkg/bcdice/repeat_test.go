@@ -0,0 +1,190 @@
+package bcdice
+
+import (
+	"errors"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+	"reflect"
+	"testing"
+)
+
+func TestExecuteCommand_Repeat(t *testing.T) {
+	testcases := []struct {
+		input    string
+		dice     []dice.Die
+		expected string
+		secret   bool
+	}{
+		{
+			input:    "x3 2D6",
+			dice:     []dice.Die{{3, 6}, {4, 6}, {1, 6}, {2, 6}, {6, 6}, {6, 6}},
+			expected: "DiceBot : (2D6) ＞ 7[3,4] ＞ 7\nDiceBot : (2D6) ＞ 3[1,2] ＞ 3\nDiceBot : (2D6) ＞ 12[6,6] ＞ 12",
+		},
+		{
+			input:    "rep2 1D6>=4 命中",
+			dice:     []dice.Die{{5, 6}, {1, 6}},
+			expected: "DiceBot : (1D6>=4) ＞ 5[5] ＞ 5 ＞ 成功\nDiceBot : (1D6>=4) ＞ 1[1] ＞ 1 ＞ 失敗",
+		},
+		{
+			input:    "REPEAT2 D66S",
+			dice:     []dice.Die{{5, 6}, {1, 6}, {2, 6}, {3, 6}},
+			expected: "DiceBot : (D66S) ＞ 15\nDiceBot : (D66S) ＞ 23",
+		},
+		{
+			input:    "2#1D6",
+			dice:     []dice.Die{{5, 6}, {1, 6}},
+			expected: "DiceBot : (1D6) ＞ 5[5] ＞ 5\nDiceBot : (1D6) ＞ 1[1] ＞ 1",
+		},
+		{
+			input:    "Sx2 1D6",
+			dice:     []dice.Die{{5, 6}, {1, 6}},
+			expected: "DiceBot : (1D6) ＞ 5[5] ＞ 5\nDiceBot : (1D6) ＞ 1[1] ＞ 1",
+			secret:   true,
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.input, func(t *testing.T) {
+			b := New(feeder.NewQueue(test.dice))
+
+			r, err := b.ExecuteCommand(test.input)
+			if err != nil {
+				t.Fatalf("コマンド実行エラー: %s", err)
+			}
+
+			actual := r.Message()
+			if actual != test.expected {
+				t.Errorf("got: %q, want: %q", actual, test.expected)
+			}
+
+			if !reflect.DeepEqual(r.RolledDice, test.dice) {
+				t.Errorf("RolledDice: got: %v, want: %v", r.RolledDice, test.dice)
+			}
+
+			if r.IsSecret != test.secret {
+				t.Errorf("IsSecret: got: %t, want: %t", r.IsSecret, test.secret)
+			}
+
+			for _, inner := range r.Results {
+				if inner.IsSecret != test.secret {
+					t.Errorf("各回の IsSecret: got: %t, want: %t", inner.IsSecret, test.secret)
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_RepeatDiceBotCommand(t *testing.T) {
+	b := New(feeder.NewQueue([]dice.Die{{3, 100}, {80, 100}}))
+	if err := b.SetDiceBotByGameID("Cthulhu"); err != nil {
+		t.Fatalf("ダイスボット設定エラー: %s", err)
+	}
+
+	r, err := b.ExecuteCommand("x2 CC<=50")
+	if err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	expected := "Cthulhu : (1D100<=50) ＞ 3 ＞ スペシャル\nCthulhu : (1D100<=50) ＞ 80 ＞ 失敗"
+	actual := r.Message()
+	if actual != expected {
+		t.Errorf("got: %q, want: %q", actual, expected)
+	}
+}
+
+func TestExecuteCommand_RepeatLimit(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	l := limits.Default()
+	l.MaxRepeats = 5
+	b.SetLimits(l)
+
+	testcases := []string{
+		"x6 2D6",
+		"99999999999999999999999#2D6",
+	}
+
+	for _, input := range testcases {
+		t.Run(input, func(t *testing.T) {
+			_, err := b.ExecuteCommand(input)
+
+			var exceeded *limits.ExceededError
+			if !errors.As(err, &exceeded) {
+				t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+			}
+
+			if exceeded.Kind != limits.REPEATS {
+				t.Errorf("Kind: got: %s, want: %s", exceeded.Kind, limits.REPEATS)
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_RepeatTotalDiceLimit(t *testing.T) {
+	b := New(feeder.NewQueue([]dice.Die{
+		{1, 6}, {2, 6}, {3, 6}, {4, 6},
+		{5, 6}, {6, 6}, {1, 6}, {2, 6},
+		{3, 6}, {4, 6}, {5, 6}, {6, 6},
+	}))
+
+	l := limits.Default()
+	l.MaxTotalDice = 10
+	b.SetLimits(l)
+
+	// 各回では上限以下だが、3回目で合計が上限を超える
+	_, err := b.ExecuteCommand("x3 4D6")
+
+	var exceeded *limits.ExceededError
+	if !errors.As(err, &exceeded) {
+		t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+	}
+
+	if exceeded.Kind != limits.TOTAL_DICE {
+		t.Errorf("Kind: got: %s, want: %s", exceeded.Kind, limits.TOTAL_DICE)
+	}
+
+	if exceeded.Value != 12 {
+		t.Errorf("Value: got: %d, want: %d", exceeded.Value, 12)
+	}
+
+	// 繰り返しの後では、振ったダイスの数は引き継がれない
+	r, err := b.ExecuteCommand("2D6")
+	if err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	if len(r.RolledDice) != 2 {
+		t.Errorf("振られたダイスの数: got: %d, want: %d", len(r.RolledDice), 2)
+	}
+}
+
+func TestExecuteCommand_RepeatInvalidCount(t *testing.T) {
+	b := New(feeder.NewQueue([]dice.Die{{1, 6}, {2, 6}}))
+
+	testcases := []string{
+		"x0 2D6",
+		"rep0 2D6",
+		"0#2D6",
+	}
+
+	for _, input := range testcases {
+		t.Run(input, func(t *testing.T) {
+			_, err := b.ExecuteCommand(input)
+			if !errors.Is(err, ErrInvalidRepeatCount) {
+				t.Errorf("ErrInvalidRepeatCount ではありません: %v", err)
+			}
+		})
+	}
+}
+
+func TestExecuteCommand_RepeatNotNested(t *testing.T) {
+	b := New(feeder.NewQueue([]dice.Die{{1, 6}, {2, 6}, {3, 6}, {4, 6}}))
+
+	_, err := b.ExecuteCommand("x2 x2 1D6")
+
+	var noMatch *NoMatchingCommandError
+	if !errors.As(err, &noMatch) {
+		t.Errorf("*NoMatchingCommandError ではありません: %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package bcdice
+
+import (
+	"errors"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+	"testing"
+)
+
+func TestExecuteCommand_Variables(t *testing.T) {
+	f := feeder.NewQueue([]dice.Die{{3, 6}, {4, 6}, {2, 6}})
+	b := New(f)
+
+	testcases := []struct {
+		input    string
+		expected string
+	}{
+		{"atk=2D6+5", "DiceBot : (atk=2D6+5) ＞ 7[3,4]+5 ＞ 12"},
+		{"{atk}+1D6", "DiceBot : (12+1D6) ＞ 12+2[2] ＞ 14"},
+		{"C({atk}*2)", "DiceBot : C(12*2) ＞ 計算結果 ＞ 24"},
+	}
+
+	for _, test := range testcases {
+		r, err := b.ExecuteCommand(test.input)
+		if err != nil {
+			t.Fatalf("%s: コマンド実行エラー: %s", test.input, err)
+		}
+
+		actual := r.Message()
+		if actual != test.expected {
+			t.Errorf("%s: got: %q, want: %q", test.input, actual, test.expected)
+		}
+	}
+}
+
+func TestExecuteCommand_Macros(t *testing.T) {
+	f := feeder.NewQueue([]dice.Die{{3, 100}})
+	b := New(f)
+	if err := b.SetDiceBotByGameID("Cthulhu"); err != nil {
+		t.Fatalf("ダイスボット設定エラー: %s", err)
+	}
+
+	r, err := b.ExecuteCommand("sanc:=CC<=50")
+	if err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	expected := "Cthulhu : (sanc:=CC<=50)"
+	actual := r.Message()
+	if actual != expected {
+		t.Errorf("got: %q, want: %q", actual, expected)
+	}
+
+	if r.IsSecret {
+		t.Error("マクロ定義がシークレットロールになっている")
+	}
+
+	if _, ok := b.Scope().Macro("sanc"); !ok {
+		t.Fatal("マクロが定義されていない")
+	}
+
+	r, err = b.ExecuteCommand("S{sanc} 正気度")
+	if err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	expected = "Cthulhu : (1D100<=50) ＞ 3 ＞ スペシャル"
+	actual = r.Message()
+	if actual != expected {
+		t.Errorf("got: %q, want: %q", actual, expected)
+	}
+
+	if !r.IsSecret {
+		t.Error("シークレットロールになっていない")
+	}
+}
+
+func TestExecuteCommand_SecretPrefixInAssignment(t *testing.T) {
+	f := feeder.NewQueue([]dice.Die{{3, 6}, {4, 6}})
+	b := New(f)
+
+	// 代入はシークレットロールとして扱わないため、"S" は変数名の一部となる
+	r, err := b.ExecuteCommand("Satk=2D6")
+	if err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	expected := "DiceBot : (Satk=2D6) ＞ 7[3,4] ＞ 7"
+	actual := r.Message()
+	if actual != expected {
+		t.Errorf("got: %q, want: %q", actual, expected)
+	}
+
+	if r.IsSecret {
+		t.Error("代入がシークレットロールになっている")
+	}
+
+	if _, ok := b.Scope().Variable("Satk"); !ok {
+		t.Error("変数 Satk が定義されていない")
+	}
+
+	if _, ok := b.Scope().Variable("atk"); ok {
+		t.Error("変数 atk が定義されている")
+	}
+}
+
+func TestExecuteCommand_VariablesLimit(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	l := limits.Default()
+	l.MaxVariables = 2
+	b.SetLimits(l)
+
+	for _, input := range []string{"a=1", "b:=2D6", "a=2"} {
+		if _, err := b.ExecuteCommand(input); err != nil {
+			t.Fatalf("%s: コマンド実行エラー: %s", input, err)
+		}
+	}
+
+	testcases := []string{
+		"c=1",
+		"c:=2D6",
+	}
+
+	for _, input := range testcases {
+		t.Run(input, func(t *testing.T) {
+			_, err := b.ExecuteCommand(input)
+
+			var exceeded *limits.ExceededError
+			if !errors.As(err, &exceeded) {
+				t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+			}
+
+			if exceeded.Kind != limits.VARIABLES {
+				t.Errorf("Kind: got: %s, want: %s", exceeded.Kind, limits.VARIABLES)
+			}
+		})
+	}
+
+	// スコープを差し替えても上限が適用される
+	b.SetScope(evaluator.NewScope())
+	for _, input := range []string{"x=1", "y=2"} {
+		if _, err := b.ExecuteCommand(input); err != nil {
+			t.Fatalf("%s: コマンド実行エラー: %s", input, err)
+		}
+	}
+
+	if _, err := b.ExecuteCommand("z=3"); err == nil {
+		t.Error("差し替えたスコープで上限超過のエラーが発生しませんでした")
+	}
+}
+
+func TestExecuteCommand_UndefinedVariable(t *testing.T) {
+	b := New(feeder.NewEmptyQueue())
+
+	_, err := b.ExecuteCommand("{undefined}+1D6")
+
+	var undefinedErr *evaluator.UndefinedVariableError
+	if !errors.As(err, &undefinedErr) {
+		t.Fatalf("*evaluator.UndefinedVariableError ではありません: %v", err)
+	}
+}
+
+func TestEngine_ExecuteCommandInScope(t *testing.T) {
+	f := feeder.NewQueue([]dice.Die{{3, 6}, {4, 6}, {2, 6}})
+	e := NewEngine(f)
+	scope := evaluator.NewScope()
+
+	if _, err := e.ExecuteCommandInScope("DiceBot", "atk=2D6+5", scope); err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	r, err := e.ExecuteCommandInScope("DiceBot", "{atk}+1D6", scope)
+	if err != nil {
+		t.Fatalf("コマンド実行エラー: %s", err)
+	}
+
+	expected := "DiceBot : (12+1D6) ＞ 12+2[2] ＞ 14"
+	actual := r.Message()
+	if actual != expected {
+		t.Errorf("got: %q, want: %q", actual, expected)
+	}
+
+	// スコープを指定しない実行では変数を共有しない
+	_, err = e.ExecuteCommand("DiceBot", "C({atk})")
+
+	var undefinedErr *evaluator.UndefinedVariableError
+	if !errors.As(err, &undefinedErr) {
+		t.Errorf("*evaluator.UndefinedVariableError ではありません: %v", err)
+	}
+}
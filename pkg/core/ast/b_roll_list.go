@@ -14,6 +14,9 @@ type BRollList struct {
 	// バラバラロールのスライス。
 	// 2b6+4d10のように連続してダイスロールを行えるように、複数のバラバラロールを格納する。
 	BRolls []*VariableInfixExpression
+	// 成功数カウントにおいて、成功数から差し引く出目（ボッチ）の上限。
+	// この値以下の出目の数が成功数から差し引かれる。指定されていない場合はNil。
+	BotchThreshold Node
 }
 
 // BRollList がNodeを実装していることの確認。
@@ -29,7 +32,8 @@ func NewBRollList(first *VariableInfixExpression) *BRollList {
 			isPrimaryExpression: false,
 		},
 
-		BRolls: []*VariableInfixExpression{first},
+		BRolls:         []*VariableInfixExpression{first},
+		BotchThreshold: NilInstance(),
 	}
 }
 
@@ -44,6 +48,13 @@ func (n *BRollList) SExp() string {
 
 	out.WriteString("(BRollList ")
 	out.WriteString(strings.Join(bRollSExps, " "))
+
+	if n.BotchThreshold != nil && !n.BotchThreshold.IsNil() {
+		out.WriteString(" (Botch ")
+		out.WriteString(n.BotchThreshold.SExp())
+		out.WriteString(")")
+	}
+
 	out.WriteString(")")
 
 	return out.String()
@@ -0,0 +1,82 @@
+package ast
+
+// D66Order はD66ダイスにおける2個のダイスの並べ方を表す型。
+type D66Order int
+
+const (
+	// D66の並べ方：未指定（ダイスボットの既定値に従う）
+	D66_ORDER_UNSPECIFIED D66Order = iota
+	// D66の並べ方：そのまま
+	D66_ORDER_NONE
+	// D66の並べ方：昇順
+	D66_ORDER_ASCENDING
+	// D66の並べ方：入れ替え（降順）
+	D66_ORDER_DESCENDING
+)
+
+// D66の並べ方とそれを表す文字列との対応。
+var d66OrderString = map[D66Order]string{
+	D66_ORDER_UNSPECIFIED: "UNSPECIFIED",
+	D66_ORDER_NONE:        "NONE",
+	D66_ORDER_ASCENDING:   "ASCENDING",
+	D66_ORDER_DESCENDING:  "DESCENDING",
+}
+
+// D66の並べ方とコマンドの接尾辞との対応。
+var d66OrderSuffix = map[D66Order]string{
+	D66_ORDER_UNSPECIFIED: "",
+	D66_ORDER_NONE:        "N",
+	D66_ORDER_ASCENDING:   "S",
+}
+
+// String はD66の並べ方を文字列として返す。
+func (o D66Order) String() string {
+	if s, ok := d66OrderString[o]; ok {
+		return s
+	}
+
+	return "UNKNOWN"
+}
+
+// Suffix はD66の並べ方を表すコマンドの接尾辞を返す。
+// 対応する接尾辞が存在しない場合は空文字列を返す。
+func (o D66Order) Suffix() string {
+	return d66OrderSuffix[o]
+}
+
+// D66ダイスのノード。
+type D66 struct {
+	NodeImpl
+	NonNilNode
+	VariableNode
+
+	// ダイスの並べ方
+	Order D66Order
+}
+
+// D66 がNodeを実装していることの確認。
+var _ Node = (*D66)(nil)
+
+// NewD66 は新しいD66ダイスのノードを返す。
+//
+// order: ダイスの並べ方。
+func NewD66(order D66Order) *D66 {
+	return &D66{
+		NodeImpl: NodeImpl{
+			nodeType:            D66_NODE,
+			isPrimaryExpression: false,
+		},
+
+		Order: order,
+	}
+}
+
+// SExp はノードのS式を返す。
+func (n *D66) SExp() string {
+	suffix := n.Order.Suffix()
+	if suffix == "" {
+		return "(D66)"
+	}
+
+	return "(D66 " + suffix + ")"
+}
@@ -0,0 +1,56 @@
+package ast
+
+// Depth はnodeを根とする抽象構文木の深さを返す。
+//
+// 子を持たないノードのみからなる木の深さは1とする。
+// nodeがnilの場合は0を返す。
+func Depth(node Node) int {
+	if node == nil || node.IsNil() {
+		return 0
+	}
+
+	maxChildDepth := 0
+	for _, child := range children(node) {
+		if d := Depth(child); d > maxChildDepth {
+			maxChildDepth = d
+		}
+	}
+
+	return maxChildDepth + 1
+}
+
+// children はノードの子のスライスを返す。
+func children(node Node) []Node {
+	switch n := node.(type) {
+	case *Command:
+		return []Node{n.Expression}
+	case *Assign:
+		return []Node{n.Expression}
+	case *BRollList:
+		nodes := make([]Node, 0, len(n.BRolls)+1)
+		for _, r := range n.BRolls {
+			nodes = append(nodes, r)
+		}
+
+		return append(nodes, n.BotchThreshold)
+	case *RRollList:
+		nodes := make([]Node, 0, len(n.RRolls)+1)
+		for _, r := range n.RRolls {
+			nodes = append(nodes, r)
+		}
+
+		return append(nodes, n.Threshold)
+	case *URollExpr:
+		if n.Bonus == nil {
+			return []Node{n.URollList}
+		}
+
+		return []Node{n.URollList, n.Bonus}
+	case PrefixExpression:
+		return []Node{n.Right()}
+	case InfixExpression:
+		return []Node{n.Left(), n.Right()}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package ast
+
+import (
+	"testing"
+)
+
+func TestDepth(t *testing.T) {
+	testcases := []struct {
+		name     string
+		node     Node
+		expected int
+	}{
+		{"nil", nil, 0},
+		{"Nil", NilInstance(), 0},
+		{"1", NewInt(1), 1},
+		{"1+2", NewAdd(NewInt(1), NewInt(2)), 2},
+		{"-(1+2)", NewUnaryMinus(NewAdd(NewInt(1), NewInt(2))), 3},
+		{"2D6", NewDRollExpr(NewDRoll(NewInt(2), NewInt(6))), 3},
+		{"(1+(2+(3+4)))", NewAdd(NewInt(1), NewAdd(NewInt(2), NewAdd(NewInt(3), NewInt(4)))), 4},
+		{"2R6[3]", NewRRollList(NewRRoll(NewInt(2), NewInt(6)), NewInt(3)), 3},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			actual := Depth(test.node)
+			if actual != test.expected {
+				t.Errorf("got: %d, want: %d", actual, test.expected)
+			}
+		})
+	}
+}
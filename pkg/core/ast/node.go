@@ -25,6 +25,9 @@ const (
 	U_ROLL_COMP_NODE
 	CALC_NODE
 	CHOICE_NODE
+	D66_NODE
+	ASSIGN_NODE
+	MACRO_DEFINITION_NODE
 
 	PREFIX_EXPRESSION_NODE
 	UNARY_MINUS_NODE
@@ -41,8 +44,17 @@ const (
 	B_ROLL_NODE
 	R_ROLL_NODE
 	U_ROLL_NODE
+	KEEP_HIGHEST_NODE
+	KEEP_LOWEST_NODE
+	DROP_HIGHEST_NODE
+	DROP_LOWEST_NODE
+	EXPLODE_NODE
+	COMPOUND_EXPLODE_NODE
+	REROLL_NODE
+	REROLL_ONCE_NODE
 	RANDOM_NUMBER_NODE
 
+	VARIABLE_REF_NODE
 	INT_NODE
 	STRING_NODE
 	NIL_NODE
@@ -63,6 +75,10 @@ var nodeTypeString = map[NodeType]string{
 	U_ROLL_COMP_NODE: "URollComp",
 	CALC_NODE:        "Calc",
 	CHOICE_NODE:      "Choice",
+	D66_NODE:         "D66",
+
+	ASSIGN_NODE:           "Assign",
+	MACRO_DEFINITION_NODE: "MacroDefinition",
 
 	PREFIX_EXPRESSION_NODE: "PrefixExpression",
 	UNARY_MINUS_NODE:       "UnaryMinus",
@@ -79,8 +95,17 @@ var nodeTypeString = map[NodeType]string{
 	B_ROLL_NODE:                    "BRoll",
 	R_ROLL_NODE:                    "RRoll",
 	U_ROLL_NODE:                    "URoll",
+	KEEP_HIGHEST_NODE:              "KeepHighest",
+	KEEP_LOWEST_NODE:               "KeepLowest",
+	DROP_HIGHEST_NODE:              "DropHighest",
+	DROP_LOWEST_NODE:               "DropLowest",
+	EXPLODE_NODE:                   "Explode",
+	COMPOUND_EXPLODE_NODE:          "CompoundExplode",
+	REROLL_NODE:                    "Reroll",
+	REROLL_ONCE_NODE:               "RerollOnce",
 	RANDOM_NUMBER_NODE:             "RandomNumber",
 
+	VARIABLE_REF_NODE:    "VariableRef",
 	INT_NODE:             "Int",
 	STRING_NODE:          "String",
 	NIL_NODE:             "Nil",
@@ -18,6 +18,9 @@ func TestNode_Type(t *testing.T) {
 		{NewURollComp(nil), "URollComp"},
 		{NewCalc(nil), "Calc"},
 		{NewChoice(nil), "Choice"},
+		{NewD66(D66_ORDER_UNSPECIFIED), "D66"},
+		{NewAssign("a", nil), "Assign"},
+		{NewMacroDefinition("a", ""), "MacroDefinition"},
 
 		{NewUnaryMinus(nil), "UnaryMinus"},
 
@@ -32,8 +35,17 @@ func TestNode_Type(t *testing.T) {
 		{NewBRoll(nil, nil), "BRoll"},
 		{NewRRoll(nil, nil), "RRoll"},
 		{NewURoll(nil, nil), "URoll"},
+		{NewKeepHighest(nil, nil), "KeepHighest"},
+		{NewKeepLowest(nil, nil), "KeepLowest"},
+		{NewDropHighest(nil, nil), "DropHighest"},
+		{NewDropLowest(nil, nil), "DropLowest"},
+		{NewExplode(nil, nil), "Explode"},
+		{NewCompoundExplode(nil, nil), "CompoundExplode"},
+		{NewReroll(nil, "=", nil), "Reroll"},
+		{NewRerollOnce(nil, "=", nil), "RerollOnce"},
 		{NewRandomNumber(nil, nil), "RandomNumber"},
 
+		{NewVariableRef("a"), "VariableRef"},
 		{NewInt(0), "Int"},
 		{NewString(""), "String"},
 		{NilInstance(), "Nil"},
@@ -64,6 +76,9 @@ func TestNode_IsNil(t *testing.T) {
 		{NewURollComp(nil), false},
 		{NewCalc(nil), false},
 		{NewChoice(nil), false},
+		{NewD66(D66_ORDER_UNSPECIFIED), false},
+		{NewAssign("a", nil), false},
+		{NewMacroDefinition("a", ""), false},
 
 		{NewUnaryMinus(nil), false},
 
@@ -78,8 +93,17 @@ func TestNode_IsNil(t *testing.T) {
 		{NewDRoll(nil, nil), false},
 		{NewBRoll(nil, nil), false},
 		{NewRRoll(nil, nil), false},
+		{NewKeepHighest(nil, nil), false},
+		{NewKeepLowest(nil, nil), false},
+		{NewDropHighest(nil, nil), false},
+		{NewDropLowest(nil, nil), false},
+		{NewExplode(nil, nil), false},
+		{NewCompoundExplode(nil, nil), false},
+		{NewReroll(nil, "=", nil), false},
+		{NewRerollOnce(nil, "=", nil), false},
 		{NewRandomNumber(nil, nil), false},
 
+		{NewVariableRef("a"), false},
 		{NewInt(0), false},
 		{NewString(""), false},
 		{NilInstance(), true},
@@ -109,6 +133,9 @@ func TestNode_IsPrimaryExpression(t *testing.T) {
 		{NewURollExpr(nil, nil), false},
 		{NewCalc(nil), false},
 		{NewChoice(nil), false},
+		{NewD66(D66_ORDER_UNSPECIFIED), false},
+		{NewAssign("a", nil), false},
+		{NewMacroDefinition("a", ""), false},
 
 		{NewUnaryMinus(nil), false},
 
@@ -123,8 +150,17 @@ func TestNode_IsPrimaryExpression(t *testing.T) {
 		{NewDRoll(nil, nil), true},
 		{NewBRoll(nil, nil), true},
 		{NewRRoll(nil, nil), true},
+		{NewKeepHighest(nil, nil), true},
+		{NewKeepLowest(nil, nil), true},
+		{NewDropHighest(nil, nil), true},
+		{NewDropLowest(nil, nil), true},
+		{NewExplode(nil, nil), true},
+		{NewCompoundExplode(nil, nil), true},
+		{NewReroll(nil, "=", nil), true},
+		{NewRerollOnce(nil, "=", nil), true},
 		{NewRandomNumber(nil, nil), true},
 
+		{NewVariableRef("a"), true},
 		{NewInt(0), true},
 		{NewString(""), true},
 		{NilInstance(), true},
@@ -154,6 +190,10 @@ func TestNode_IsVariable(t *testing.T) {
 			node:     NewInt(42),
 			expected: false,
 		},
+		{
+			node:     NewVariableRef("atk"),
+			expected: false,
+		},
 		{
 			node: NewDRoll(
 				NewInt(2),
@@ -182,6 +222,26 @@ func TestNode_IsVariable(t *testing.T) {
 			),
 			expected: true,
 		},
+		{
+			node: NewKeepHighest(
+				NewDRoll(
+					NewInt(4),
+					NewInt(6),
+				),
+				NewInt(3),
+			),
+			expected: true,
+		},
+		{
+			node: NewDropLowest(
+				NewDRoll(
+					NewInt(4),
+					NewInt(6),
+				),
+				NewInt(1),
+			),
+			expected: true,
+		},
 		{
 			node: NewRandomNumber(
 				NewInt(3),
@@ -391,6 +451,10 @@ func TestNode_IsVariable(t *testing.T) {
 			node:     NewChoice(NewString("hello")),
 			expected: true,
 		},
+		{
+			node:     NewD66(D66_ORDER_NONE),
+			expected: true,
+		},
 	}
 
 	for _, test := range testcases {
@@ -0,0 +1,97 @@
+package ast
+
+import (
+	"fmt"
+)
+
+// Reroll は、条件を満たす出目のダイスを振り直す加算ロールのノード。
+//
+// 振り直されたダイスは捨てられ、振り直したダイスに置き換えられる。
+type Reroll struct {
+	VariableInfixExpression
+
+	// CompareOperator は振り直す条件の比較演算子。
+	CompareOperator string
+}
+
+// Reroll がNodeを実装していることの確認。
+var _ Node = (*Reroll)(nil)
+
+// Reroll がInfixExpressionを実装していることの確認。
+var _ InfixExpression = (*Reroll)(nil)
+
+// nodeTypeToRerollOperator はノードの種類と演算子との対応。
+var nodeTypeToRerollOperator = map[NodeType]string{
+	REROLL_NODE:      "R",
+	REROLL_ONCE_NODE: "RO",
+}
+
+// newReroll は振り直しつき加算ロールの新しいノードを返す。
+func newReroll(
+	dRoll Node,
+	compareOperator string,
+	threshold Node,
+	nodeType NodeType,
+) *Reroll {
+	return &Reroll{
+		VariableInfixExpression: VariableInfixExpression{
+			InfixExpressionImpl: InfixExpressionImpl{
+				NodeImpl: NodeImpl{
+					nodeType:            nodeType,
+					isPrimaryExpression: true,
+				},
+
+				left:               dRoll,
+				operator:           nodeTypeToRerollOperator[nodeType],
+				operatorForSExp:    nodeType.String(),
+				right:              threshold,
+				precedence:         PREC_ROLL,
+				isLeftAssociative:  false,
+				isRightAssociative: false,
+			},
+		},
+
+		CompareOperator: compareOperator,
+	}
+}
+
+// NewReroll は、条件を満たさなくなるまでダイスを振り直す加算ロールのノードを返す。
+//
+// dRoll: 加算ロールのノード,
+// compareOperator: 振り直す条件の比較演算子,
+// threshold: 振り直す条件の閾値のノード。
+func NewReroll(dRoll Node, compareOperator string, threshold Node) *Reroll {
+	return newReroll(dRoll, compareOperator, threshold, REROLL_NODE)
+}
+
+// NewRerollOnce は、条件を満たすダイスを1回だけ振り直す加算ロールのノードを返す。
+//
+// dRoll: 加算ロールのノード,
+// compareOperator: 振り直す条件の比較演算子,
+// threshold: 振り直す条件の閾値のノード。
+func NewRerollOnce(dRoll Node, compareOperator string, threshold Node) *Reroll {
+	return newReroll(dRoll, compareOperator, threshold, REROLL_ONCE_NODE)
+}
+
+// Once は、1回だけ振り直すかどうかを返す。
+func (n *Reroll) Once() bool {
+	return n.Type() == REROLL_ONCE_NODE
+}
+
+// SExp はノードのS式を返す。
+//
+// 例：(RerollOnce (DRoll 4 6) < 3)
+func (n *Reroll) SExp() string {
+	leftSExp := "nil"
+	if n.Left() != nil {
+		leftSExp = n.Left().SExp()
+	}
+
+	rightSExp := "nil"
+	if n.Right() != nil {
+		rightSExp = n.Right().SExp()
+	}
+
+	return fmt.Sprintf("(%s %s %s %s)",
+		n.OperatorForSExp(), leftSExp, n.CompareOperator, rightSExp)
+}
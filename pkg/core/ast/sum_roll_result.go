@@ -14,6 +14,14 @@ type SumRollResult struct {
 
 	// 振られたダイスの配列
 	Dice []dice.Die
+	// 各ダイスが捨てられたかどうかの配列。
+	// Diceと同じ長さを持つ。
+	Dropped []bool
+	// 各ダイスが直前のダイスに続けて振り足された（振り直された）ものかどうかの配列。
+	// 振り足しや振り直しを行わない場合はnil。
+	Rerolled []bool
+	// 振り足した出目を元のダイスの出目に加えるか（上方無限加算ロール）
+	Compounding bool
 }
 
 // SumRollResult がNodeを実装していることの確認。
@@ -29,7 +37,8 @@ func NewSumRollResult(rolledDice []dice.Die) *SumRollResult {
 			isPrimaryExpression: true,
 		},
 
-		Dice: make([]dice.Die, len(rolledDice)),
+		Dice:    make([]dice.Die, len(rolledDice)),
+		Dropped: make([]bool, len(rolledDice)),
 	}
 
 	copy(r.Dice, rolledDice)
@@ -37,23 +46,87 @@ func NewSumRollResult(rolledDice []dice.Die) *SumRollResult {
 	return r
 }
 
-// Value は出目の合計を返す。
+// NewSumRollResultWithRerolls は振り足しや振り直しを含む加算ロールの結果のノードを返す。
+//
+// rolledDice: 振られたダイスのスライス。振り足された（振り直された）ダイスは元のダイスの直後に並ぶ,
+// rerolled: 各ダイスが振り足された（振り直された）ものかどうかのスライス,
+// compounding: 振り足した出目を元のダイスの出目に加えるか。
+func NewSumRollResultWithRerolls(
+	rolledDice []dice.Die,
+	rerolled []bool,
+	compounding bool,
+) *SumRollResult {
+	r := NewSumRollResult(rolledDice)
+	r.Rerolled = make([]bool, len(rolledDice))
+	copy(r.Rerolled, rerolled)
+	r.Compounding = compounding
+
+	return r
+}
+
+// Value は捨てられていないダイスの出目の合計を返す。
 func (n *SumRollResult) Value() int {
 	sum := 0
 
-	for _, d := range n.Dice {
+	for i, d := range n.Dice {
+		if n.IsDropped(i) {
+			continue
+		}
+
 		sum += d.Value
 	}
 
 	return sum
 }
 
+// IsDropped はi番目のダイスが捨てられたかどうかを返す。
+func (n *SumRollResult) IsDropped(i int) bool {
+	return i < len(n.Dropped) && n.Dropped[i]
+}
+
+// IsRerolled はi番目のダイスが振り足されたものかどうかを返す。
+func (n *SumRollResult) IsRerolled(i int) bool {
+	return i < len(n.Rerolled) && n.Rerolled[i]
+}
+
+// Drop はi番目のダイスを捨てられたものとして記録する。
+func (n *SumRollResult) Drop(i int) {
+	n.Dropped[i] = true
+}
+
+// KeptDice は捨てられていないダイスのスライスを返す。
+func (n *SumRollResult) KeptDice() []dice.Die {
+	kept := make([]dice.Die, 0, len(n.Dice))
+
+	for i, d := range n.Dice {
+		if !n.IsDropped(i) {
+			kept = append(kept, d)
+		}
+	}
+
+	return kept
+}
+
 // SExp はノードのS式を返す。
+//
+// 捨てられたダイスは (Dropped (Die 1 6)) のように、
+// 振り足されたダイスは (Rerolled (Die 6 6)) のように示される。
+// 振り直した後でさらに振り直されたダイスは (Dropped (Rerolled (Die 1 6))) となる。
 func (n *SumRollResult) SExp() string {
 	diceStrs := []string{}
 
-	for _, d := range n.Dice {
-		diceStrs = append(diceStrs, d.SExp())
+	for i, d := range n.Dice {
+		dieStr := d.SExp()
+
+		if n.IsRerolled(i) {
+			dieStr = "(Rerolled " + dieStr + ")"
+		}
+
+		if n.IsDropped(i) {
+			dieStr = "(Dropped " + dieStr + ")"
+		}
+
+		diceStrs = append(diceStrs, dieStr)
 	}
 
 	return "(SumRollResult " + strings.Join(diceStrs, " ") + ")"
@@ -0,0 +1,127 @@
+package ast
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// 変数参照のノード。
+// 一次式。
+//
+// 1回のコマンドの実行中は値が変わらないため、可変ノードではない。
+type VariableRef struct {
+	NodeImpl
+	NonNilNode
+	ConstNode
+
+	// 変数名
+	Name string
+}
+
+// VariableRef がNodeを実装していることの確認。
+var _ Node = (*VariableRef)(nil)
+
+// NewVariableRef は新しい変数参照のノードを返す。
+//
+// name: 変数名。
+func NewVariableRef(name string) *VariableRef {
+	return &VariableRef{
+		NodeImpl: NodeImpl{
+			nodeType:            VARIABLE_REF_NODE,
+			isPrimaryExpression: true,
+		},
+
+		Name: name,
+	}
+}
+
+// SExp はノードのS式を返す。
+func (n *VariableRef) SExp() string {
+	return "{" + n.Name + "}"
+}
+
+// 変数への代入のノード。
+type Assign struct {
+	NodeImpl
+	NonNilNode
+
+	// 変数名
+	Name string
+	// 代入する値を表す式
+	Expression Node
+}
+
+// Assign がNodeを実装していることの確認。
+var _ Node = (*Assign)(nil)
+
+// NewAssign は新しい変数への代入のノードを返す。
+//
+// name: 変数名,
+// expression: 代入する値を表す式。
+func NewAssign(name string, expression Node) *Assign {
+	return &Assign{
+		NodeImpl: NodeImpl{
+			nodeType:            ASSIGN_NODE,
+			isPrimaryExpression: false,
+		},
+
+		Name:       name,
+		Expression: expression,
+	}
+}
+
+// IsVariable は可変ノードかどうかを返す。
+//
+// 代入では、代入する値を表す式が可変ノードならばtrueを返す。
+func (n *Assign) IsVariable() bool {
+	return n.Expression.IsVariable()
+}
+
+// SExp はノードのS式を返す。
+func (n *Assign) SExp() string {
+	var buf bytes.Buffer
+
+	buf.WriteString("(Assign ")
+	buf.WriteString(n.Name)
+	buf.WriteString(" ")
+	buf.WriteString(n.Expression.SExp())
+	buf.WriteString(")")
+
+	return buf.String()
+}
+
+// マクロ定義のノード。
+type MacroDefinition struct {
+	NodeImpl
+	NonNilNode
+	ConstNode
+
+	// マクロ名
+	Name string
+	// マクロの本体（展開されるコマンド）
+	Body string
+}
+
+// MacroDefinition がNodeを実装していることの確認。
+var _ Node = (*MacroDefinition)(nil)
+
+// NewMacroDefinition は新しいマクロ定義のノードを返す。
+//
+// name: マクロ名,
+// body: マクロの本体。
+func NewMacroDefinition(name string, body string) *MacroDefinition {
+	return &MacroDefinition{
+		NodeImpl: NodeImpl{
+			nodeType:            MACRO_DEFINITION_NODE,
+			isPrimaryExpression: false,
+		},
+
+		Name: name,
+		Body: body,
+	}
+}
+
+// SExp はノードのS式を返す。
+func (n *MacroDefinition) SExp() string {
+	return fmt.Sprintf("(MacroDefinition %s %q)", n.Name, n.Body)
+}
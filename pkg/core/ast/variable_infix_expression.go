@@ -8,20 +8,32 @@ type VariableInfixExpression struct {
 
 // variableInfixExpressionOperator はノードの種類と演算子との対応。
 var variableInfixExpressionOperator = map[NodeType]string{
-	D_ROLL_NODE:        "D",
-	B_ROLL_NODE:        "B",
-	R_ROLL_NODE:        "R",
-	U_ROLL_NODE:        "U",
-	RANDOM_NUMBER_NODE: "...",
+	D_ROLL_NODE:           "D",
+	B_ROLL_NODE:           "B",
+	R_ROLL_NODE:           "R",
+	U_ROLL_NODE:           "U",
+	KEEP_HIGHEST_NODE:     "KH",
+	KEEP_LOWEST_NODE:      "KL",
+	DROP_HIGHEST_NODE:     "DH",
+	DROP_LOWEST_NODE:      "DL",
+	EXPLODE_NODE:          "!",
+	COMPOUND_EXPLODE_NODE: "!!",
+	RANDOM_NUMBER_NODE:    "...",
 }
 
 // variableInfixExpressionPrecedence はノードの種類と演算子の優先順位との対応。
 var variableInfixExpressionPrecedence = map[NodeType]OperatorPrecedenceType{
-	D_ROLL_NODE:        PREC_ROLL,
-	B_ROLL_NODE:        PREC_ROLL,
-	R_ROLL_NODE:        PREC_ROLL,
-	U_ROLL_NODE:        PREC_ROLL,
-	RANDOM_NUMBER_NODE: PREC_DOTS,
+	D_ROLL_NODE:           PREC_ROLL,
+	B_ROLL_NODE:           PREC_ROLL,
+	R_ROLL_NODE:           PREC_ROLL,
+	U_ROLL_NODE:           PREC_ROLL,
+	KEEP_HIGHEST_NODE:     PREC_ROLL,
+	KEEP_LOWEST_NODE:      PREC_ROLL,
+	DROP_HIGHEST_NODE:     PREC_ROLL,
+	DROP_LOWEST_NODE:      PREC_ROLL,
+	EXPLODE_NODE:          PREC_ROLL,
+	COMPOUND_EXPLODE_NODE: PREC_ROLL,
+	RANDOM_NUMBER_NODE:    PREC_DOTS,
 }
 
 func newVariableInfixExpression(
@@ -82,6 +94,56 @@ func NewURoll(num Node, sides Node) *VariableInfixExpression {
 	return newVariableInfixExpression(num, sides, U_ROLL_NODE)
 }
 
+// NewKeepHighest は新しい大きい出目を残す加算ロールのノードを返す。
+//
+// dRoll: 加算ロールのノード,
+// count: 残すダイスの数のノード。
+func NewKeepHighest(dRoll Node, count Node) *VariableInfixExpression {
+	return newVariableInfixExpression(dRoll, count, KEEP_HIGHEST_NODE)
+}
+
+// NewKeepLowest は新しい小さい出目を残す加算ロールのノードを返す。
+//
+// dRoll: 加算ロールのノード,
+// count: 残すダイスの数のノード。
+func NewKeepLowest(dRoll Node, count Node) *VariableInfixExpression {
+	return newVariableInfixExpression(dRoll, count, KEEP_LOWEST_NODE)
+}
+
+// NewDropHighest は新しい大きい出目を捨てる加算ロールのノードを返す。
+//
+// dRoll: 加算ロールのノード,
+// count: 捨てるダイスの数のノード。
+func NewDropHighest(dRoll Node, count Node) *VariableInfixExpression {
+	return newVariableInfixExpression(dRoll, count, DROP_HIGHEST_NODE)
+}
+
+// NewDropLowest は新しい小さい出目を捨てる加算ロールのノードを返す。
+//
+// dRoll: 加算ロールのノード,
+// count: 捨てるダイスの数のノード。
+func NewDropLowest(dRoll Node, count Node) *VariableInfixExpression {
+	return newVariableInfixExpression(dRoll, count, DROP_LOWEST_NODE)
+}
+
+// NewExplode は新しい振り足しつき加算ロールのノードを返す。
+// 閾値以上の出目が出るたびに、ダイスを1個振り足して合計に加える。
+//
+// dRoll: 加算ロールのノード,
+// threshold: 振り足しの閾値のノード。Nilの場合はダイスの面数が閾値となる。
+func NewExplode(dRoll Node, threshold Node) *VariableInfixExpression {
+	return newVariableInfixExpression(dRoll, threshold, EXPLODE_NODE)
+}
+
+// NewCompoundExplode は新しい上方無限加算ロールのノードを返す。
+// 閾値以上の出目が出るたびに、同じダイスに振り足した出目を加える。
+//
+// dRoll: 加算ロールのノード,
+// threshold: 振り足しの閾値のノード。Nilの場合はダイスの面数が閾値となる。
+func NewCompoundExplode(dRoll Node, threshold Node) *VariableInfixExpression {
+	return newVariableInfixExpression(dRoll, threshold, COMPOUND_EXPLODE_NODE)
+}
+
 // NewRandomNumber はランダム数値取り出しのノードを返す。
 //
 // min: 最小値のノード,
@@ -15,6 +15,7 @@ import (
 )
 
 // Execute は指定されたコマンドを実行する。
+// コマンド中の変数参照は、実行前に変数の値に置き換えられる。
 //
 // node: コマンドのノード,
 // gameID: ゲーム識別子,
@@ -24,6 +25,11 @@ func Execute(
 	gameID string,
 	evaluator *evaluator.Evaluator,
 ) (*Result, error) {
+	// 変数参照を変数の値に置き換える
+	if err := evaluator.ResolveVariableRefs(node); err != nil {
+		return nil, err
+	}
+
 	switch c := node.(type) {
 	case *ast.Command:
 		return executeCommand(c, gameID, evaluator)
@@ -35,6 +41,12 @@ func Execute(
 		return executeURollExpr(c, gameID, evaluator)
 	case *ast.Choice:
 		return executeChoice(c, gameID, evaluator)
+	case *ast.D66:
+		return executeD66(c, gameID, evaluator)
+	case *ast.Assign:
+		return executeAssign(c, gameID, evaluator)
+	case *ast.MacroDefinition:
+		return executeMacroDefinition(c, gameID, evaluator)
 	}
 
 	return nil, fmt.Errorf("command execution not implemented: %s", node.Type())
@@ -0,0 +1,450 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+)
+
+// Detail はコマンドの実行結果の構造化された詳細を表す構造体。
+//
+// メッセージとは異なり、ダイスごとの情報を機械的に扱える形式で保持する。
+type Detail struct {
+	// ダイスロールのグループの配列
+	Groups []*RollGroup `json:"groups"`
+	// 成功判定の比較。成功判定を行わない場合はnil。
+	Comparison *Comparison `json:"comparison,omitempty"`
+	// 合計値。合計値を求めない場合はnil。
+	Total *int `json:"total,omitempty"`
+	// 成功数。成功数を数えない場合はnil。
+	// ボッチを差し引く場合は、差し引いた後の値となる。
+	NumOfSuccesses *int `json:"numOfSuccesses,omitempty"`
+	// 成功数から差し引いたボッチの数。ボッチを数えない場合はnil。
+	NumOfBotches *int `json:"numOfBotches,omitempty"`
+}
+
+// RollGroup は、まとめて振られたダイスのグループを表す構造体。
+//
+// 2D6+1D4 の場合は 2D6 と 1D4 の2つのグループとなる。
+// 個数振り足しロールでは、振り足しごとに新しいグループが作られ、
+// RerollOf に振り足しのきっかけとなったグループの添字が設定される。
+type RollGroup struct {
+	// グループの表記（例："2D6"）
+	Notation string `json:"notation"`
+	// ダイスの面の数
+	Sides int `json:"sides"`
+	// ダイスの詳細の配列
+	Dice []*DieDetail `json:"dice"`
+	// 捨てられていないダイスの出目（振り足しを含む）の合計
+	Sum int `json:"sum"`
+	// 振り足しのきっかけとなったグループの添字。振り足しでない場合はnil。
+	RerollOf *int `json:"rerollOf,omitempty"`
+}
+
+// DieDetail は1個のダイスの詳細を表す構造体。
+type DieDetail struct {
+	// 出目
+	Value int `json:"value"`
+	// 捨てられたかどうか
+	Dropped bool `json:"dropped,omitempty"`
+	// 上方無限ロールで、このダイスに続けて振り足された出目の連鎖
+	Rerolls []int `json:"rerolls,omitempty"`
+	// 成功判定の結果。成功判定を行わない場合はnil。
+	// 振り足しの連鎖がある場合は、連鎖の合計で判定した結果となる。
+	Success *bool `json:"success,omitempty"`
+	// 成功数から差し引かれるボッチかどうか
+	Botch bool `json:"botch,omitempty"`
+}
+
+// Comparison は成功判定の比較を表す構造体。
+type Comparison struct {
+	// 比較演算子（例：">="）
+	Operator string `json:"operator"`
+	// 目標値
+	Target int `json:"target"`
+}
+
+// newDetail は、グループを持たない新しい詳細を返す。
+func newDetail() *Detail {
+	return &Detail{
+		Groups: []*RollGroup{},
+	}
+}
+
+// Clone は詳細のコピーを返す。
+func (d *Detail) Clone() *Detail {
+	if d == nil {
+		return nil
+	}
+
+	copiedDetail := &Detail{
+		Groups:         make([]*RollGroup, 0, len(d.Groups)),
+		Total:          copyIntPtr(d.Total),
+		NumOfSuccesses: copyIntPtr(d.NumOfSuccesses),
+		NumOfBotches:   copyIntPtr(d.NumOfBotches),
+	}
+
+	if d.Comparison != nil {
+		comparison := *d.Comparison
+		copiedDetail.Comparison = &comparison
+	}
+
+	for _, g := range d.Groups {
+		copiedDetail.Groups = append(copiedDetail.Groups, g.clone())
+	}
+
+	return copiedDetail
+}
+
+// clone はグループのコピーを返す。
+func (g *RollGroup) clone() *RollGroup {
+	copiedGroup := *g
+	copiedGroup.RerollOf = copyIntPtr(g.RerollOf)
+	copiedGroup.Dice = make([]*DieDetail, 0, len(g.Dice))
+
+	for _, d := range g.Dice {
+		copiedDie := *d
+		if d.Rerolls != nil {
+			copiedDie.Rerolls = append([]int{}, d.Rerolls...)
+		}
+
+		if d.Success != nil {
+			success := *d.Success
+			copiedDie.Success = &success
+		}
+
+		copiedGroup.Dice = append(copiedGroup.Dice, &copiedDie)
+	}
+
+	return &copiedGroup
+}
+
+// copyIntPtr は、pが指す整数のコピーへのポインタを返す。pがnilの場合はnilを返す。
+func copyIntPtr(p *int) *int {
+	if p == nil {
+		return nil
+	}
+
+	v := *p
+	return &v
+}
+
+// SetTotal は合計値を設定する。
+func (d *Detail) SetTotal(total int) {
+	d.Total = &total
+}
+
+// SetNumOfSuccesses は成功数を設定する。
+func (d *Detail) SetNumOfSuccesses(n int) {
+	d.NumOfSuccesses = &n
+}
+
+// IsSumRollComparison は、加算ロール式の成功判定（例："2D6>=7"）の詳細かどうかを返す。
+//
+// 合計値と比較を持ち、成功数を持たない詳細が該当する。
+// バラバラロールや振り足しロールの成功数カウントは該当しない。
+func (d *Detail) IsSumRollComparison() bool {
+	return d != nil &&
+		d.Comparison != nil &&
+		d.Total != nil &&
+		d.NumOfSuccesses == nil
+}
+
+// markSuccesses は、各ダイスの成功判定の結果を記録する。
+//
+// modifier: 振り足しの連鎖の合計に加える修正値。
+func (d *Detail) markSuccesses(modifier int) {
+	for _, g := range d.Groups {
+		for _, die := range g.Dice {
+			value := die.Value + modifier
+			for _, r := range die.Rerolls {
+				value += r
+			}
+
+			success := compareValues(value, d.Comparison.Operator, d.Comparison.Target)
+			die.Success = &success
+		}
+	}
+}
+
+// SetNumOfBotches はボッチの数を設定する。
+func (d *Detail) SetNumOfBotches(n int) {
+	d.NumOfBotches = &n
+}
+
+// markBotches は、出目が閾値以下のダイスにボッチの印を付ける。
+func (d *Detail) markBotches(threshold int) {
+	for _, g := range d.Groups {
+		for _, die := range g.Dice {
+			die.Botch = die.Value <= threshold
+		}
+	}
+}
+
+// newRollGroup は、ダイス列から新しいグループを作る。
+func newRollGroup(notation string, sides int, ds []dice.Die) *RollGroup {
+	g := &RollGroup{
+		Notation: notation,
+		Sides:    sides,
+		Dice:     make([]*DieDetail, 0, len(ds)),
+	}
+
+	for _, d := range ds {
+		g.Dice = append(g.Dice, &DieDetail{Value: d.Value})
+		g.Sum += d.Value
+	}
+
+	return g
+}
+
+// rollGroupsOfSumRollResults は、値が決定された抽象構文木に含まれる
+// 加算ロール結果からグループを作る。
+//
+// グループは、ダイスが振られた順（式の左から右）に並ぶ。
+func rollGroupsOfSumRollResults(node ast.Node) []*RollGroup {
+	groups := []*RollGroup{}
+
+	var walk func(n ast.Node)
+	walk = func(n ast.Node) {
+		switch v := n.(type) {
+		case *ast.Command:
+			walk(v.Expression)
+		case *ast.SumRollResult:
+			groups = append(groups, rollGroupOfSumRollResult(v))
+		case ast.PrefixExpression:
+			walk(v.Right())
+		case ast.InfixExpression:
+			walk(v.Left())
+			walk(v.Right())
+		}
+	}
+	walk(node)
+
+	return groups
+}
+
+// rollGroupOfSumRollResult は加算ロール結果からグループを作る。
+//
+// 振り足されたダイスの出目は、元のダイスの振り足しの連鎖として記録する。
+// 振り直したダイスは、捨てられた元のダイスの直後に別のダイスとして記録する。
+func rollGroupOfSumRollResult(r *ast.SumRollResult) *RollGroup {
+	sides := 0
+	if len(r.Dice) > 0 {
+		sides = r.Dice[0].Sides
+	}
+
+	g := &RollGroup{
+		Sides: sides,
+		Dice:  make([]*DieDetail, 0, len(r.Dice)),
+		Sum:   r.Value(),
+	}
+
+	// 振り足しや振り直しによらずに振られたダイスの数
+	numOfDice := 0
+
+	var last *DieDetail
+	for i, d := range r.Dice {
+		if r.IsRerolled(i) && last != nil && !last.Dropped {
+			last.Rerolls = append(last.Rerolls, d.Value)
+			continue
+		}
+
+		if !r.IsRerolled(i) {
+			numOfDice++
+		}
+
+		last = &DieDetail{
+			Value:   d.Value,
+			Dropped: r.IsDropped(i),
+		}
+		g.Dice = append(g.Dice, last)
+	}
+	g.Notation = fmt.Sprintf("%dD%d", numOfDice, sides)
+
+	return g
+}
+
+// rollGroupsOfBRollList は、バラバラロール列の振られたダイスからグループを作る。
+// 可変ノードの引数は評価済みでなければならない。
+func rollGroupsOfBRollList(node *ast.BRollList, rolledDice []dice.Die) []*RollGroup {
+	groups := make([]*RollGroup, 0, len(node.BRolls))
+
+	rest := rolledDice
+	for _, b := range node.BRolls {
+		num, sides := numAndSides(b)
+		if num > len(rest) {
+			num = len(rest)
+		}
+
+		groups = append(groups, newRollGroup(fmt.Sprintf("%dB%d", num, sides), sides, rest[:num]))
+		rest = rest[num:]
+	}
+
+	return groups
+}
+
+// rollGroupsOfRRollList は、個数振り足しロールの出目のグループからグループを作る。
+//
+// 評価器と同じ順で振り足しのキューをたどり、各グループの面の数と振り足しの元を求める。
+func rollGroupsOfRRollList(
+	node *ast.RRollList,
+	valueGroups *object.Array,
+	threshold int,
+) []*RollGroup {
+	// キューに入っているロールの、面の数と振り足しの元のグループの添字
+	type queued struct {
+		sides    int
+		rerollOf *int
+	}
+
+	queue := make([]queued, 0, len(node.RRolls))
+	for _, r := range node.RRolls {
+		_, sides := numAndSides(r)
+		queue = append(queue, queued{sides: sides})
+	}
+
+	groups := make([]*RollGroup, 0, valueGroups.Length())
+	for i, valuesObj := range valueGroups.Elements {
+		if len(queue) == 0 {
+			break
+		}
+
+		q := queue[0]
+		queue = queue[1:]
+
+		ds := integersToDice(valuesObj.(*object.Array), q.sides)
+		g := newRollGroup(fmt.Sprintf("%dR%d", len(ds), q.sides), q.sides, ds)
+		g.RerollOf = q.rerollOf
+		groups = append(groups, g)
+
+		numToReroll := 0
+		for _, d := range ds {
+			if d.Value >= threshold {
+				numToReroll++
+			}
+		}
+
+		if numToReroll > 0 {
+			parent := i
+			queue = append(queue, queued{sides: q.sides, rerollOf: &parent})
+		}
+	}
+
+	return groups
+}
+
+// rollGroupsOfURollList は、上方無限ロールの出目のグループからグループを作る。
+//
+// 上方無限ロールの各ダイスは、振り足しの連鎖を Rerolls に持つ。
+func rollGroupsOfURollList(node *ast.RRollList, valueGroups *object.Array) []*RollGroup {
+	groups := make([]*RollGroup, 0, len(node.RRolls))
+
+	rest := valueGroups.Elements
+	for _, u := range node.RRolls {
+		num, sides := numAndSides(u)
+		if num > len(rest) {
+			num = len(rest)
+		}
+
+		g := &RollGroup{
+			Notation: fmt.Sprintf("%dU%d", num, sides),
+			Sides:    sides,
+			Dice:     make([]*DieDetail, 0, num),
+		}
+
+		for _, chainObj := range rest[:num] {
+			chain := chainObj.(*object.Array)
+			die := &DieDetail{}
+
+			for j, vObj := range chain.Elements {
+				v := vObj.(*object.Integer).Value
+				if j == 0 {
+					die.Value = v
+				} else {
+					die.Rerolls = append(die.Rerolls, v)
+				}
+
+				g.Sum += v
+			}
+
+			g.Dice = append(g.Dice, die)
+		}
+
+		groups = append(groups, g)
+		rest = rest[num:]
+	}
+
+	return groups
+}
+
+// thresholdValue は、評価済みの振り足しの閾値を返す。
+// 整数でない場合は0を返す。
+func thresholdValue(node *ast.RRollList) int {
+	if t, ok := node.Threshold.(*ast.Int); ok {
+		return t.Value
+	}
+
+	return 0
+}
+
+// numAndSides は、評価済みのダイスロールのノードから個数と面の数を取り出す。
+// 整数でない場合は0を返す。
+func numAndSides(node ast.InfixExpression) (int, int) {
+	num := 0
+	if n, ok := node.Left().(*ast.Int); ok {
+		num = n.Value
+	}
+
+	sides := 0
+	if s, ok := node.Right().(*ast.Int); ok {
+		sides = s.Value
+	}
+
+	return num, sides
+}
+
+// integersToDice は、整数の配列を、指定した面の数のダイス列に変換する。
+func integersToDice(values *object.Array, sides int) []dice.Die {
+	ds := make([]dice.Die, 0, values.Length())
+	for _, v := range values.Elements {
+		ds = append(ds, dice.Die{Value: v.(*object.Integer).Value, Sides: sides})
+	}
+
+	return ds
+}
+
+// newComparison は、比較式のノードから成功判定の比較を作る。
+// 右辺は評価済みでなければならない。
+func newComparison(node *ast.BasicInfixExpression) *Comparison {
+	target := 0
+	if t, ok := node.Right().(*ast.Int); ok {
+		target = t.Value
+	}
+
+	return &Comparison{
+		Operator: node.Operator(),
+		Target:   target,
+	}
+}
+
+// compareValues は、比較演算子に従って左辺と右辺を比較する。
+func compareValues(left int, operator string, right int) bool {
+	switch operator {
+	case "=":
+		return left == right
+	case "<>":
+		return left != right
+	case "<":
+		return left < right
+	case ">":
+		return left > right
+	case "<=":
+		return left <= right
+	case ">=":
+		return left >= right
+	}
+
+	return false
+}
@@ -0,0 +1,175 @@
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/parser"
+)
+
+// marshalJSON は、比較演算子をエスケープせずにvをJSONに変換する。
+func marshalJSON(v interface{}) (string, error) {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func TestDetail(t *testing.T) {
+	testcases := []struct {
+		input    string
+		dice     []dice.Die
+		expected string
+	}{
+		{
+			input:    "C(1+2)",
+			expected: `{"groups":[],"total":3}`,
+		},
+		{
+			input:    "2D6+1D4-1",
+			dice:     []dice.Die{{3, 6}, {5, 6}, {2, 4}},
+			expected: `{"groups":[{"notation":"2D6","sides":6,"dice":[{"value":3},{"value":5}],"sum":8},{"notation":"1D4","sides":4,"dice":[{"value":2}],"sum":2}],"total":9}`,
+		},
+		{
+			input:    "3D6KH2",
+			dice:     []dice.Die{{3, 6}, {1, 6}, {5, 6}},
+			expected: `{"groups":[{"notation":"3D6","sides":6,"dice":[{"value":3},{"value":1,"dropped":true},{"value":5}],"sum":8}],"total":8}`,
+		},
+		{
+			input:    "2D6>=7",
+			dice:     []dice.Die{{3, 6}, {5, 6}},
+			expected: `{"groups":[{"notation":"2D6","sides":6,"dice":[{"value":3},{"value":5}],"sum":8}],"comparison":{"operator":">=","target":7},"total":8}`,
+		},
+		{
+			input:    "2B6+1B10",
+			dice:     []dice.Die{{3, 6}, {5, 6}, {8, 10}},
+			expected: `{"groups":[{"notation":"2B6","sides":6,"dice":[{"value":3},{"value":5}],"sum":8},{"notation":"1B10","sides":10,"dice":[{"value":8}],"sum":8}]}`,
+		},
+		{
+			input:    "2B6>4",
+			dice:     []dice.Die{{3, 6}, {5, 6}},
+			expected: `{"groups":[{"notation":"2B6","sides":6,"dice":[{"value":3,"success":false},{"value":5,"success":true}],"sum":8}],"comparison":{"operator":">","target":4},"numOfSuccesses":1}`,
+		},
+		{
+			input:    "2R4+2R6[4]",
+			dice:     []dice.Die{{4, 4}, {3, 4}, {3, 6}, {5, 6}, {1, 4}, {2, 6}},
+			expected: `{"groups":[{"notation":"2R4","sides":4,"dice":[{"value":4},{"value":3}],"sum":7},{"notation":"2R6","sides":6,"dice":[{"value":3},{"value":5}],"sum":8},{"notation":"1R4","sides":4,"dice":[{"value":1}],"sum":1,"rerollOf":0},{"notation":"1R6","sides":6,"dice":[{"value":2}],"sum":2,"rerollOf":1}]}`,
+		},
+		{
+			input:    "2R6>=3",
+			dice:     []dice.Die{{6, 6}, {1, 6}, {3, 6}, {1, 6}},
+			expected: `{"groups":[{"notation":"2R6","sides":6,"dice":[{"value":6,"success":true},{"value":1,"success":false}],"sum":7},{"notation":"1R6","sides":6,"dice":[{"value":3,"success":true}],"sum":3,"rerollOf":0},{"notation":"1R6","sides":6,"dice":[{"value":1,"success":false}],"sum":1,"rerollOf":1}],"comparison":{"operator":">=","target":3},"numOfSuccesses":2}`,
+		},
+		{
+			input:    "2U6[6]+1",
+			dice:     []dice.Die{{6, 6}, {6, 6}, {2, 6}, {3, 6}},
+			expected: `{"groups":[{"notation":"2U6","sides":6,"dice":[{"value":6,"rerolls":[6,2]},{"value":3}],"sum":17}],"total":18}`,
+		},
+		{
+			input:    "2U6[6]+1>=5",
+			dice:     []dice.Die{{6, 6}, {2, 6}, {3, 6}},
+			expected: `{"groups":[{"notation":"2U6","sides":6,"dice":[{"value":6,"rerolls":[2],"success":true},{"value":3,"success":false}],"sum":11}],"comparison":{"operator":">=","target":5},"numOfSuccesses":1}`,
+		},
+		{
+			input:    "2D6!+1",
+			dice:     []dice.Die{{6, 6}, {4, 6}, {2, 6}},
+			expected: `{"groups":[{"notation":"2D6","sides":6,"dice":[{"value":6,"rerolls":[2]},{"value":4}],"sum":12}],"total":13}`,
+		},
+		{
+			input:    "2D6r1",
+			dice:     []dice.Die{{1, 6}, {4, 6}, {3, 6}},
+			expected: `{"groups":[{"notation":"2D6","sides":6,"dice":[{"value":1,"dropped":true},{"value":3},{"value":4}],"sum":7}],"total":7}`,
+		},
+		{
+			input:    "D66",
+			dice:     []dice.Die{{5, 6}, {2, 6}},
+			expected: `{"groups":[{"notation":"D66","sides":6,"dice":[{"value":5},{"value":2}],"sum":7}],"total":52}`,
+		},
+		{
+			input:    "CHOICE[a,b,c]",
+			dice:     []dice.Die{{2, 3}},
+			expected: `{"groups":[{"notation":"1D3","sides":3,"dice":[{"value":2}],"sum":2}]}`,
+		},
+	}
+
+	for _, test := range testcases {
+		name := fmt.Sprintf(
+			"%q[%s]",
+			test.input,
+			dice.FormatDiceWithoutSpaces(test.dice),
+		)
+		t.Run(name, func(t *testing.T) {
+			root, parseErr := parser.Parse("test", []byte(test.input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+				return
+			}
+
+			// ノードを評価する
+			dieFeeder := feeder.NewQueue(test.dice)
+			evaluator := evaluator.NewEvaluator(
+				roller.New(dieFeeder),
+				evaluator.NewEnvironment(),
+			)
+
+			r, execErr := Execute(root.(ast.Node), "DiceBot", evaluator)
+			if execErr != nil {
+				t.Fatalf("コマンド実行エラー: %s", execErr)
+				return
+			}
+
+			actual, err := marshalJSON(r.Detail)
+			if err != nil {
+				t.Fatalf("JSONへの変換エラー: %s", err)
+				return
+			}
+
+			if actual != test.expected {
+				t.Errorf("詳細が異なる:\ngot  %s\nwant %s", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestResult_JSON(t *testing.T) {
+	r := &Result{
+		GameID:             "DiceBot",
+		MessageParts:       []string{"(1D6>=4)", "5", "成功"},
+		RolledDice:         []dice.Die{{5, 6}},
+		SuccessCheckResult: SUCCESS_CHECK_SUCCESS,
+	}
+
+	actual, err := marshalJSON(r)
+	if err != nil {
+		t.Fatalf("JSONへの変換エラー: %s", err)
+	}
+
+	expected := `{"gameId":"DiceBot","messageParts":["(1D6>=4)","5","成功"],` +
+		`"rolledDice":[{"value":5,"sides":6}],"successCheckResult":"SUCCESS","secret":false}`
+	if actual != expected {
+		t.Errorf("got: %s, want: %s", actual, expected)
+	}
+
+	var decoded Result
+	if err := json.Unmarshal([]byte(actual), &decoded); err != nil {
+		t.Fatalf("JSONからの変換エラー: %s", err)
+	}
+
+	if decoded.SuccessCheckResult != SUCCESS_CHECK_SUCCESS {
+		t.Errorf("成功判定結果が異なる: got %s, want %s",
+			decoded.SuccessCheckResult, SUCCESS_CHECK_SUCCESS)
+	}
+}
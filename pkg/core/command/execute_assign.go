@@ -0,0 +1,60 @@
+package command
+
+import (
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/notation"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+)
+
+// executeAssign は変数への代入を実行する。
+//
+// 代入する値を表す式は加算ロール式と同様に評価し、得られた整数を変数に代入する。
+func executeAssign(
+	node *ast.Assign,
+	gameID string,
+	evaluator *evaluator.Evaluator,
+) (*Result, error) {
+	result := &Result{
+		GameID: gameID,
+		Detail: newDetail(),
+	}
+
+	expr := ast.NewDRollExpr(node.Expression)
+
+	// 加算ロールなどの可変ノードの引数を評価して整数に変換する
+	infixNotation1, evalVarArgsErr := evalVarArgs(expr, evaluator)
+	if evalVarArgsErr != nil {
+		return nil, evalVarArgsErr
+	}
+
+	// 加算ロールなどの可変ノードの値を決定する
+	infixNotation2, determineValuesErr := determineValues(expr, evaluator)
+	if determineValuesErr != nil {
+		return nil, determineValuesErr
+	}
+
+	// 変換された抽象構文木を評価する
+	obj, evalErr := evaluator.Eval(expr)
+	if evalErr != nil {
+		return nil, evalErr
+	}
+
+	value := obj.(*object.Integer).Value
+	if setErr := evaluator.SetVariable(node.Name, value); setErr != nil {
+		return nil, setErr
+	}
+
+	result.RolledDice = evaluator.RolledDice()
+	result.Detail.Groups = rollGroupsOfSumRollResults(expr)
+	result.Detail.SetTotal(value)
+
+	// 結果のメッセージを作る
+	result.AppendMessagePart(notation.Parenthesize(node.Name + "=" + infixNotation1))
+	if infixNotation2 != infixNotation1 {
+		result.AppendMessagePart(infixNotation2)
+	}
+	result.AppendMessagePart(obj.Inspect())
+
+	return result, nil
+}
@@ -0,0 +1,182 @@
+package command
+
+import (
+	"fmt"
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/parser"
+	"reflect"
+	"testing"
+)
+
+func TestExecuteAssign(t *testing.T) {
+	testcases := []struct {
+		input         string
+		expected      string
+		expectedValue int
+		dice          []dice.Die
+	}{
+		{
+			input:         "atk=2D6+5",
+			expected:      "DiceBot : (atk=2D6+5) ＞ 7[3,4]+5 ＞ 12",
+			expectedValue: 12,
+			dice:          []dice.Die{{3, 6}, {4, 6}},
+		},
+		{
+			input:         "atk=5",
+			expected:      "DiceBot : (atk=5) ＞ 5",
+			expectedValue: 5,
+			dice:          []dice.Die{},
+		},
+		{
+			input:         "atk=(1+2)*3",
+			expected:      "DiceBot : (atk=(1+2)*3) ＞ 9",
+			expectedValue: 9,
+			dice:          []dice.Die{},
+		},
+		{
+			input:         "atk=(1+1)D6",
+			expected:      "DiceBot : (atk=2D6) ＞ 2[1,1] ＞ 2",
+			expectedValue: 2,
+			dice:          []dice.Die{{1, 6}, {1, 6}},
+		},
+		{
+			input:         "atk={base}*2-1D6",
+			expected:      "DiceBot : (atk=10*2-1D6) ＞ 10*2-6[6] ＞ 14",
+			expectedValue: 14,
+			dice:          []dice.Die{{6, 6}},
+		},
+	}
+
+	for _, test := range testcases {
+		name := fmt.Sprintf(
+			"%q[%s]",
+			test.input,
+			dice.FormatDiceWithoutSpaces(test.dice),
+		)
+		t.Run(name, func(t *testing.T) {
+			root, parseErr := parser.Parse("test", []byte(test.input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+			}
+
+			if root.(ast.Node).Type() != ast.ASSIGN_NODE {
+				t.Fatal("Assignではない")
+			}
+
+			// ノードを評価する
+			env := evaluator.NewEnvironment()
+			env.SetVariable("base", 10)
+
+			dieFeeder := feeder.NewQueue(test.dice)
+			evaluator := evaluator.NewEvaluator(roller.New(dieFeeder), env)
+
+			r, execErr := Execute(root.(ast.Node), "DiceBot", evaluator)
+			if execErr != nil {
+				t.Fatalf("コマンド実行エラー: %s", execErr)
+			}
+
+			actualMessage := r.Message()
+			if actualMessage != test.expected {
+				t.Errorf("結果のメッセージが異なる: got %q, want %q", actualMessage, test.expected)
+			}
+
+			if !reflect.DeepEqual(r.RolledDice, test.dice) {
+				t.Errorf("ダイスロール結果が異なる: got [%s], want [%s]",
+					dice.FormatDice(r.RolledDice), dice.FormatDice(test.dice))
+			}
+
+			value, ok := env.Variable("atk")
+			if !ok {
+				t.Fatal("変数に代入されていない")
+			}
+
+			if value != test.expectedValue {
+				t.Errorf("変数の値が異なる: got %d, want %d", value, test.expectedValue)
+			}
+		})
+	}
+}
+
+func TestExecuteMacroDefinition(t *testing.T) {
+	root, parseErr := parser.Parse("test", []byte("sanc:=CC<=50 正気度"))
+	if parseErr != nil {
+		t.Fatalf("構文エラー: %s", parseErr)
+	}
+
+	env := evaluator.NewEnvironment()
+	evaluator := evaluator.NewEvaluator(roller.New(feeder.NewEmptyQueue()), env)
+
+	r, execErr := Execute(root.(ast.Node), "DiceBot", evaluator)
+	if execErr != nil {
+		t.Fatalf("コマンド実行エラー: %s", execErr)
+	}
+
+	expected := "DiceBot : (sanc:=CC<=50 正気度)"
+	actual := r.Message()
+	if actual != expected {
+		t.Errorf("結果のメッセージが異なる: got %q, want %q", actual, expected)
+	}
+
+	body, ok := env.Macro("sanc")
+	if !ok {
+		t.Fatal("マクロが定義されていない")
+	}
+
+	if body != "CC<=50 正気度" {
+		t.Errorf("マクロの本体が異なる: got %q, want %q", body, "CC<=50 正気度")
+	}
+}
+
+func TestExecute_VariableRef(t *testing.T) {
+	testcases := []struct {
+		input    string
+		expected string
+		dice     []dice.Die
+	}{
+		{
+			input:    "{atk}+1D6",
+			expected: "DiceBot : (12+1D6) ＞ 12+3[3] ＞ 15",
+			dice:     []dice.Die{{3, 6}},
+		},
+		{
+			input:    "2D6+{neg}>=7",
+			expected: "DiceBot : (2D6+(-3)>=7) ＞ 9[4,5]+(-3) ＞ 6 ＞ 失敗",
+			dice:     []dice.Die{{4, 6}, {5, 6}},
+		},
+		{
+			input:    "C({atk}/2)",
+			expected: "DiceBot : C(12/2) ＞ 計算結果 ＞ 6",
+			dice:     []dice.Die{},
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.input, func(t *testing.T) {
+			root, parseErr := parser.Parse("test", []byte(test.input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+			}
+
+			env := evaluator.NewEnvironment()
+			env.SetVariable("atk", 12)
+			env.SetVariable("neg", -3)
+
+			dieFeeder := feeder.NewQueue(test.dice)
+			evaluator := evaluator.NewEvaluator(roller.New(dieFeeder), env)
+
+			r, execErr := Execute(root.(ast.Node), "DiceBot", evaluator)
+			if execErr != nil {
+				t.Fatalf("コマンド実行エラー: %s", execErr)
+			}
+
+			actualMessage := r.Message()
+			if actualMessage != test.expected {
+				t.Errorf("結果のメッセージが異なる: got %q, want %q", actualMessage, test.expected)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package command
 import (
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/notation"
 	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
@@ -15,13 +16,13 @@ func executeBRollComp(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
-	// 左辺の可変ノードの引数および右辺を評価する
-	infixNotation, evalVarArgsErr := evalVarArgs(
-		node.Expression.(*ast.BasicInfixExpression),
-		evaluator,
-	)
+	compareNode := node.Expression.(*ast.BasicInfixExpression)
+
+	// 左辺の可変ノードの引数、右辺およびボッチの閾値を評価する
+	infixNotation, evalVarArgsErr := evalVarArgs(node, evaluator)
 	if evalVarArgsErr != nil {
 		return nil, evalVarArgsErr
 	}
@@ -34,11 +35,41 @@ func executeBRollComp(
 
 	resultObj := obj.(*object.BRollCompResult)
 	result.RolledDice = evaluator.RolledDice()
+	result.Detail.Groups = rollGroupsOfBRollList(
+		compareNode.Left().(*ast.BRollList),
+		result.RolledDice,
+	)
+	result.Detail.Comparison = newComparison(compareNode)
+	result.Detail.markSuccesses(0)
+	result.Detail.SetNumOfSuccesses(resultObj.NetNumOfSuccesses())
+
+	if !resultObj.HasBotches() {
+		// 結果のメッセージを作る
+		result.AppendMessagePart(notation.Parenthesize(infixNotation))
+		result.AppendMessagePart(resultObj.Values.JoinedElements(","))
+		result.AppendMessagePart(evaluator.Message(locale.NUM_OF_SUCCESSES, resultObj.NumOfSuccesses.Value))
+
+		return result, nil
+	}
+
+	botchThreshold := compareNode.Left().(*ast.BRollList).BotchThreshold.(*ast.Int).Value
+	result.Detail.markBotches(botchThreshold)
+	result.Detail.SetNumOfBotches(resultObj.NumOfBotches.Value)
 
 	// 結果のメッセージを作る
 	result.AppendMessagePart(notation.Parenthesize(infixNotation))
 	result.AppendMessagePart(resultObj.Values.JoinedElements(","))
-	result.AppendMessagePart("成功数" + resultObj.NumOfSuccesses.Inspect())
+	result.AppendMessagePart(evaluator.Message(
+		locale.SUCCESSES_AND_BOTCHES,
+		resultObj.NumOfSuccesses.Value,
+		resultObj.NumOfBotches.Value,
+	))
+	result.AppendMessagePart(evaluator.Message(locale.NUM_OF_SUCCESSES, resultObj.NetNumOfSuccesses()))
+
+	if resultObj.IsGlitch() {
+		result.AppendMessagePart(evaluator.Message(locale.BOTCH))
+		result.SuccessCheckResult = SUCCESS_CHECK_FUMBLE
+	}
 
 	return result, nil
 }
@@ -205,3 +205,103 @@ func TestExecuteBRollComp(t *testing.T) {
 		})
 	}
 }
+
+func TestExecuteBRollComp_Botch(t *testing.T) {
+	testcases := []struct {
+		input                      string
+		expected                   string
+		expectedNumOfSuccesses     int
+		expectedNumOfBotches       int
+		expectedSuccessCheckResult SuccessCheckResultType
+		dice                       []dice.Die
+	}{
+		{
+			input:                      "4b10>=8[1]",
+			expected:                   "DiceBot : (4B10>=8[1]) ＞ 8,1,10,5 ＞ 成功2, ボッチ1 ＞ 成功数1",
+			expectedNumOfSuccesses:     1,
+			expectedNumOfBotches:       1,
+			expectedSuccessCheckResult: SUCCESS_CHECK_UNSPECIFIED,
+			dice:                       []dice.Die{{8, 10}, {1, 10}, {10, 10}, {5, 10}},
+		},
+		{
+			input:                      "4b10>=8[1]",
+			expected:                   "DiceBot : (4B10>=8[1]) ＞ 9,1,1,4 ＞ 成功1, ボッチ2 ＞ 成功数0",
+			expectedNumOfSuccesses:     0,
+			expectedNumOfBotches:       2,
+			expectedSuccessCheckResult: SUCCESS_CHECK_UNSPECIFIED,
+			dice:                       []dice.Die{{9, 10}, {1, 10}, {1, 10}, {4, 10}},
+		},
+		{
+			input:                      "3b10>=8[1]",
+			expected:                   "DiceBot : (3B10>=8[1]) ＞ 1,3,7 ＞ 成功0, ボッチ1 ＞ 成功数0 ＞ 大失敗",
+			expectedNumOfSuccesses:     0,
+			expectedNumOfBotches:       1,
+			expectedSuccessCheckResult: SUCCESS_CHECK_FUMBLE,
+			dice:                       []dice.Die{{1, 10}, {3, 10}, {7, 10}},
+		},
+		{
+			input:                      "3b10>=8[1]",
+			expected:                   "DiceBot : (3B10>=8[1]) ＞ 2,3,7 ＞ 成功0, ボッチ0 ＞ 成功数0",
+			expectedNumOfSuccesses:     0,
+			expectedNumOfBotches:       0,
+			expectedSuccessCheckResult: SUCCESS_CHECK_UNSPECIFIED,
+			dice:                       []dice.Die{{2, 10}, {3, 10}, {7, 10}},
+		},
+		{
+			input:                      "2b6>=5[1+1]",
+			expected:                   "DiceBot : (2B6>=5[2]) ＞ 5,2 ＞ 成功1, ボッチ1 ＞ 成功数0",
+			expectedNumOfSuccesses:     0,
+			expectedNumOfBotches:       1,
+			expectedSuccessCheckResult: SUCCESS_CHECK_UNSPECIFIED,
+			dice:                       []dice.Die{{5, 6}, {2, 6}},
+		},
+	}
+
+	for _, test := range testcases {
+		name := fmt.Sprintf(
+			"%q[%s]",
+			test.input,
+			dice.FormatDiceWithoutSpaces(test.dice),
+		)
+		t.Run(name, func(t *testing.T) {
+			root, parseErr := parser.Parse("test", []byte(test.input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+				return
+			}
+
+			// ノードを評価する
+			dieFeeder := feeder.NewQueue(test.dice)
+			evaluator := evaluator.NewEvaluator(
+				roller.New(dieFeeder),
+				evaluator.NewEnvironment(),
+			)
+
+			r, execErr := Execute(root.(*ast.Command), "DiceBot", evaluator)
+			if execErr != nil {
+				t.Fatalf("コマンド実行エラー: %s", execErr)
+				return
+			}
+
+			actualMessage := r.Message()
+			if actualMessage != test.expected {
+				t.Errorf("結果のメッセージが異なる: got %q, want %q", actualMessage, test.expected)
+			}
+
+			if r.Detail.NumOfSuccesses == nil || *r.Detail.NumOfSuccesses != test.expectedNumOfSuccesses {
+				t.Errorf("詳細の成功数が異なる: got %v, want %d",
+					r.Detail.NumOfSuccesses, test.expectedNumOfSuccesses)
+			}
+
+			if r.Detail.NumOfBotches == nil || *r.Detail.NumOfBotches != test.expectedNumOfBotches {
+				t.Errorf("詳細のボッチの数が異なる: got %v, want %d",
+					r.Detail.NumOfBotches, test.expectedNumOfBotches)
+			}
+
+			if r.SuccessCheckResult != test.expectedSuccessCheckResult {
+				t.Errorf("成功判定結果が異なる: got %s, want %s",
+					r.SuccessCheckResult, test.expectedSuccessCheckResult)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ func executeBRollList(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
 	// 可変ノードの引数を評価して整数に変換する
@@ -32,6 +33,7 @@ func executeBRollList(
 	arrayObj := obj.(*object.Array)
 
 	result.RolledDice = evaluator.RolledDice()
+	result.Detail.Groups = rollGroupsOfBRollList(node, result.RolledDice)
 
 	// 結果のメッセージを作る
 	result.AppendMessagePart(notation.Parenthesize(infixNotation))
@@ -3,7 +3,9 @@ package command
 import (
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/notation"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
 
 // executeCalc は計算を実行する。
@@ -14,6 +16,7 @@ func executeCalc(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
 	// 抽象構文木を中置表記に変換する
@@ -28,9 +31,13 @@ func executeCalc(
 		return nil, evalErr
 	}
 
+	if totalObj, ok := obj.(*object.Integer); ok {
+		result.Detail.SetTotal(totalObj.Value)
+	}
+
 	// 結果のメッセージを作る
 	result.AppendMessagePart(infixNotation)
-	result.AppendMessagePart("計算結果")
+	result.AppendMessagePart(evaluator.Message(locale.CALC_RESULT))
 	result.AppendMessagePart(obj.Inspect())
 
 	return result, nil
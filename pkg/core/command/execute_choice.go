@@ -1,7 +1,10 @@
 package command
 
 import (
+	"fmt"
+
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
 	"github.com/raa0121/GoBCDice/pkg/core/notation"
 	"github.com/raa0121/GoBCDice/pkg/core/object"
@@ -15,6 +18,7 @@ func executeChoice(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
 	// 中置表記を記録しておく
@@ -31,6 +35,10 @@ func executeChoice(
 
 	resultObj := obj.(*object.String)
 	result.RolledDice = evaluator.RolledDice()
+	for _, d := range result.RolledDice {
+		result.Detail.Groups = append(result.Detail.Groups,
+			newRollGroup(fmt.Sprintf("1D%d", d.Sides), d.Sides, []dice.Die{d}))
+	}
 
 	// 結果のメッセージを作る
 	result.AppendMessagePart(notation.Parenthesize(infixNotation))
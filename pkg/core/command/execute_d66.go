@@ -0,0 +1,44 @@
+package command
+
+import (
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/notation"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+)
+
+// executeD66 はD66ダイスを実行する。
+func executeD66(
+	node *ast.D66,
+	gameID string,
+	evaluator *evaluator.Evaluator,
+) (*Result, error) {
+	result := &Result{
+		GameID: gameID,
+		Detail: newDetail(),
+	}
+
+	// 中置表記を記録しておく
+	infixNotation, infixNotationErr := notation.InfixNotation(node, true)
+	if infixNotationErr != nil {
+		return nil, infixNotationErr
+	}
+
+	// 抽象構文木を評価する
+	obj, evalErr := evaluator.Eval(node)
+	if evalErr != nil {
+		return nil, evalErr
+	}
+
+	result.RolledDice = evaluator.RolledDice()
+	result.Detail.Groups = []*RollGroup{newRollGroup("D66", 6, result.RolledDice)}
+	if totalObj, ok := obj.(*object.Integer); ok {
+		result.Detail.SetTotal(totalObj.Value)
+	}
+
+	// 結果のメッセージを作る
+	result.AppendMessagePart(notation.Parenthesize(infixNotation))
+	result.AppendMessagePart(obj.Inspect())
+
+	return result, nil
+}
@@ -0,0 +1,105 @@
+package command
+
+import (
+	"fmt"
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/parser"
+	"reflect"
+	"testing"
+)
+
+func TestExecuteD66(t *testing.T) {
+	testcases := []struct {
+		input        string
+		defaultOrder ast.D66Order
+		expected     string
+		dice         []dice.Die
+	}{
+		{
+			input:        "D66",
+			defaultOrder: ast.D66_ORDER_NONE,
+			expected:     "DiceBot : (D66) ＞ 52",
+			dice:         []dice.Die{{5, 6}, {2, 6}},
+		},
+		{
+			input:        "d66",
+			defaultOrder: ast.D66_ORDER_ASCENDING,
+			expected:     "DiceBot : (D66) ＞ 25",
+			dice:         []dice.Die{{5, 6}, {2, 6}},
+		},
+		{
+			input:        "D66",
+			defaultOrder: ast.D66_ORDER_DESCENDING,
+			expected:     "DiceBot : (D66) ＞ 52",
+			dice:         []dice.Die{{2, 6}, {5, 6}},
+		},
+		{
+			input:        "D66N",
+			defaultOrder: ast.D66_ORDER_ASCENDING,
+			expected:     "DiceBot : (D66N) ＞ 52",
+			dice:         []dice.Die{{5, 6}, {2, 6}},
+		},
+		{
+			input:        "D66S",
+			defaultOrder: ast.D66_ORDER_NONE,
+			expected:     "DiceBot : (D66S) ＞ 25",
+			dice:         []dice.Die{{5, 6}, {2, 6}},
+		},
+	}
+
+	for _, test := range testcases {
+		name := fmt.Sprintf(
+			"%q(%s)[%s]",
+			test.input,
+			test.defaultOrder,
+			dice.FormatDiceWithoutSpaces(test.dice),
+		)
+		t.Run(name, func(t *testing.T) {
+			root, parseErr := parser.Parse("test", []byte(test.input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+				return
+			}
+
+			d66Node, rootIsD66 := root.(*ast.D66)
+			if !rootIsD66 {
+				t.Fatal("D66ではない")
+			}
+
+			// ノードを評価する
+			dieFeeder := feeder.NewQueue(test.dice)
+			evaluator := evaluator.NewEvaluator(
+				roller.New(dieFeeder),
+				evaluator.NewEnvironment(),
+			)
+			evaluator.DefaultD66Order = test.defaultOrder
+
+			r, execErr := Execute(d66Node, "DiceBot", evaluator)
+			if execErr != nil {
+				t.Fatalf("コマンド実行エラー: %s", execErr)
+				return
+			}
+
+			actualMessage := r.Message()
+			if actualMessage != test.expected {
+				t.Errorf("結果のメッセージが異なる: got %q, want %q", actualMessage, test.expected)
+			}
+
+			if !reflect.DeepEqual(r.RolledDice, test.dice) {
+				t.Errorf("ダイスロール結果が異なる: got [%s], want [%s]",
+					dice.FormatDice(r.RolledDice), dice.FormatDice(test.dice))
+			}
+
+			expectedSuccessCheckResult := SUCCESS_CHECK_UNSPECIFIED
+			actualSuccessCheckResult := r.SuccessCheckResult
+			if actualSuccessCheckResult != expectedSuccessCheckResult {
+				t.Errorf("成功判定結果が異なる: got %s, want %s",
+					actualSuccessCheckResult, expectedSuccessCheckResult)
+			}
+		})
+	}
+}
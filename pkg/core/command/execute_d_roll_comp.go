@@ -5,6 +5,7 @@ import (
 
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/notation"
 	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
@@ -17,6 +18,7 @@ func executeDRollComp(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
 	if node.Expression.Type() != ast.COMPARE_NODE {
@@ -37,6 +39,9 @@ func executeDRollComp(
 		return nil, determineValuesErr
 	}
 
+	// 左辺の評価で加算ロール結果が整数に置き換えられる前に、グループを記録する
+	result.Detail.Groups = rollGroupsOfSumRollResults(compareNode.Left())
+
 	// 左辺を評価する
 	leftObj, leftEvalErr := evaluator.EvalCompareLeft(compareNode)
 	if leftEvalErr != nil {
@@ -50,6 +55,10 @@ func executeDRollComp(
 	}
 
 	result.RolledDice = evaluator.RolledDice()
+	result.Detail.Comparison = newComparison(compareNode)
+	if totalObj, ok := leftObj.(*object.Integer); ok {
+		result.Detail.SetTotal(totalObj.Value)
+	}
 
 	var successCheckResultMessage string
 
@@ -60,10 +69,10 @@ func executeDRollComp(
 
 	if boolObj.Value == true {
 		result.SuccessCheckResult = SUCCESS_CHECK_SUCCESS
-		successCheckResultMessage = "成功"
+		successCheckResultMessage = evaluator.Message(locale.SUCCESS)
 	} else {
 		result.SuccessCheckResult = SUCCESS_CHECK_FAILURE
-		successCheckResultMessage = "失敗"
+		successCheckResultMessage = evaluator.Message(locale.FAILURE)
 	}
 
 	result.AppendMessagePart(notation.Parenthesize(infixNotation1))
@@ -163,6 +163,18 @@ func TestExecuteDRollComp(t *testing.T) {
 			expectedSuccessCheckResult: SUCCESS_CHECK_FAILURE,
 			dice:                       []dice.Die{{3, 6}, {5, 6}},
 		},
+		{
+			input:                      "2D6!>=5>=10",
+			expectedMessage:            "DiceBot : (2D6!>=5>=10) ＞ 12[5,3,4] ＞ 12 ＞ 成功",
+			expectedSuccessCheckResult: SUCCESS_CHECK_SUCCESS,
+			dice:                       []dice.Die{{5, 6}, {4, 6}, {3, 6}},
+		},
+		{
+			input:                      "2D6!>=5>=10",
+			expectedMessage:            "DiceBot : (2D6!>=5>=10) ＞ 7[3,4] ＞ 7 ＞ 失敗",
+			expectedSuccessCheckResult: SUCCESS_CHECK_FAILURE,
+			dice:                       []dice.Die{{3, 6}, {4, 6}},
+		},
 	}
 
 	for _, test := range testcases {
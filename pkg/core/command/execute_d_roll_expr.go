@@ -4,6 +4,7 @@ import (
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
 	"github.com/raa0121/GoBCDice/pkg/core/notation"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
 
 // executeDRollExpr は加算ロールを実行する。
@@ -14,6 +15,7 @@ func executeDRollExpr(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
 	// 加算ロールなどの可変ノードの引数を評価して整数に変換する
@@ -35,6 +37,10 @@ func executeDRollExpr(
 	}
 
 	result.RolledDice = evaluator.RolledDice()
+	result.Detail.Groups = rollGroupsOfSumRollResults(node)
+	if totalObj, ok := obj.(*object.Integer); ok {
+		result.Detail.SetTotal(totalObj.Value)
+	}
 
 	// 結果のメッセージを作る
 	result.AppendMessagePart(notation.Parenthesize(infixNotation1))
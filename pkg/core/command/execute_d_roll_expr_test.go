@@ -188,6 +188,66 @@ func TestExecuteDRollExpr(t *testing.T) {
 			expected: "DiceBot : (3D6-1) ＞ 14[5,5,4]-1 ＞ 13",
 			dice:     []dice.Die{{2, 4}, {3, 3}, {5, 6}, {5, 6}, {4, 6}},
 		},
+		{
+			input:    "4D6KH3",
+			expected: "DiceBot : (4D6KH3) ＞ 13[3,(1),6,4] ＞ 13",
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "2d20kl1+5",
+			expected: "DiceBot : (2D20KL1+5) ＞ 8[(15),8]+5 ＞ 13",
+			dice:     []dice.Die{{15, 20}, {8, 20}},
+		},
+		{
+			input:    "4D6DH1",
+			expected: "DiceBot : (4D6DH1) ＞ 8[3,1,(6),4] ＞ 8",
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "4D6DL1",
+			expected: "DiceBot : (4D6DL1) ＞ 13[3,(1),6,4] ＞ 13",
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "(1+3)D6KH(2+1)",
+			expected: "DiceBot : (4D6KH3) ＞ 12[(2),2,5,5] ＞ 12",
+			dice:     []dice.Die{{2, 6}, {2, 6}, {5, 6}, {5, 6}},
+		},
+		{
+			input:    "1D6!",
+			expected: "DiceBot : (1D6!) ＞ 15[6,6,3] ＞ 15",
+			dice:     []dice.Die{{6, 6}, {6, 6}, {3, 6}},
+		},
+		{
+			input:    "2D6!+1",
+			expected: "DiceBot : (2D6!+1) ＞ 12[6,2,4]+1 ＞ 13",
+			dice:     []dice.Die{{6, 6}, {4, 6}, {2, 6}},
+		},
+		{
+			input:    "2D10!>=(4+4)",
+			expected: "DiceBot : (2D10!>=8) ＞ 23[9,1,10,3] ＞ 23",
+			dice:     []dice.Die{{9, 10}, {10, 10}, {1, 10}, {3, 10}},
+		},
+		{
+			input:    "2D6r1",
+			expected: "DiceBot : (2D6R1) ＞ 7[(1),(1),3,4] ＞ 7",
+			dice:     []dice.Die{{1, 6}, {4, 6}, {1, 6}, {3, 6}},
+		},
+		{
+			input:    "4D6ro<3+1",
+			expected: "DiceBot : (4D6RO<3+1) ＞ 16[5,(2),6,3,(1),2]+1 ＞ 17",
+			dice:     []dice.Die{{5, 6}, {2, 6}, {3, 6}, {1, 6}, {6, 6}, {2, 6}},
+		},
+		{
+			input:    "1D6!!",
+			expected: "DiceBot : (1D6!!) ＞ 15[6+6+3] ＞ 15",
+			dice:     []dice.Die{{6, 6}, {6, 6}, {3, 6}},
+		},
+		{
+			input:    "2D6!!>=5",
+			expected: "DiceBot : (2D6!!>=5) ＞ 14[2,5+6+1] ＞ 14",
+			dice:     []dice.Die{{2, 6}, {5, 6}, {6, 6}, {1, 6}},
+		},
 	}
 
 	for _, test := range testcases {
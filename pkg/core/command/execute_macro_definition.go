@@ -0,0 +1,28 @@
+package command
+
+import (
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/notation"
+)
+
+// executeMacroDefinition はマクロ定義を実行する。
+func executeMacroDefinition(
+	node *ast.MacroDefinition,
+	gameID string,
+	evaluator *evaluator.Evaluator,
+) (*Result, error) {
+	if setErr := evaluator.SetMacro(node.Name, node.Body); setErr != nil {
+		return nil, setErr
+	}
+
+	result := &Result{
+		GameID: gameID,
+		Detail: newDetail(),
+	}
+
+	// 結果のメッセージを作る
+	result.AppendMessagePart(notation.Parenthesize(node.Name + ":=" + node.Body))
+
+	return result, nil
+}
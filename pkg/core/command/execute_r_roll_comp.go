@@ -3,6 +3,7 @@ package command
 import (
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/notation"
 	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
@@ -15,6 +16,7 @@ func executeRRollComp(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
 	compareNode := node.Expression.(*ast.BasicInfixExpression)
@@ -56,9 +58,19 @@ func executeRRollComp(
 	resultObj := obj.(*object.RRollCompResult)
 	result.RolledDice = evaluator.RolledDice()
 
+	rRollList := compareNode.Left().(*ast.RRollList)
+	result.Detail.Groups = rollGroupsOfRRollList(
+		rRollList,
+		resultObj.ValueGroups,
+		thresholdValue(rRollList),
+	)
+	result.Detail.Comparison = newComparison(compareNode)
+	result.Detail.markSuccesses(0)
+	result.Detail.SetNumOfSuccesses(resultObj.NumOfSuccesses.Value)
+
 	// 結果のメッセージを作る
 	result.AppendMessagePart(formatRRollValues(resultObj.ValueGroups))
-	result.AppendMessagePart("成功数" + resultObj.NumOfSuccesses.Inspect())
+	result.AppendMessagePart(evaluator.Message(locale.NUM_OF_SUCCESSES, resultObj.NumOfSuccesses.Value))
 
 	return result, nil
 }
@@ -17,6 +17,7 @@ func executeRRollList(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
 	// 可変ノードの引数を評価して整数に変換する
@@ -42,6 +43,7 @@ func executeRRollList(
 
 	valueGroups := obj.(*object.Array)
 	result.RolledDice = evaluator.RolledDice()
+	result.Detail.Groups = rollGroupsOfRRollList(node, valueGroups, thresholdValue(node))
 
 	// 結果のメッセージを作る
 	result.AppendMessagePart(formatRRollValues(valueGroups))
@@ -3,6 +3,7 @@ package command
 import (
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/notation"
 	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
@@ -15,6 +16,7 @@ func executeURollComp(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
 	compareNode := node.Expression.(*ast.BasicInfixExpression)
@@ -50,12 +52,19 @@ func executeURollComp(
 
 	resultObj := obj.(*object.URollCompResult)
 	result.RolledDice = evaluator.RolledDice()
+	result.Detail.Groups = rollGroupsOfURollList(
+		compareNode.Left().(*ast.URollExpr).URollList,
+		resultObj.RollResult.ValueGroups(),
+	)
+	result.Detail.Comparison = newComparison(compareNode)
+	result.Detail.markSuccesses(resultObj.RollResult.Modifier().Value)
+	result.Detail.SetNumOfSuccesses(resultObj.NumOfSuccesses.Value)
 
 	// 結果のメッセージを作る
 	result.AppendMessagePart(
 		formatURollExprValueGroupsAndModifier(resultObj.RollResult),
 	)
-	result.AppendMessagePart("成功数" + resultObj.NumOfSuccesses.Inspect())
+	result.AppendMessagePart(evaluator.Message(locale.NUM_OF_SUCCESSES, resultObj.NumOfSuccesses.Value))
 
 	return result, nil
 }
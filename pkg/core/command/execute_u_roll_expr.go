@@ -2,11 +2,11 @@ package command
 
 import (
 	"bytes"
-	"fmt"
 	"strings"
 
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/notation"
 	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
@@ -19,6 +19,7 @@ func executeURollExpr(
 ) (*Result, error) {
 	result := &Result{
 		GameID: gameID,
+		Detail: newDetail(),
 	}
 
 	// 可変ノードの引数を評価して整数に変換する
@@ -44,10 +45,12 @@ func executeURollExpr(
 
 	uRollExprResult := obj.(*object.URollExprResult)
 	result.RolledDice = evaluator.RolledDice()
+	result.Detail.Groups = rollGroupsOfURollList(node.URollList, uRollExprResult.ValueGroups())
+	result.Detail.SetTotal(uRollExprResult.SumOfValues().Value)
 
 	result.AppendMessagePart(formatURollExprValueGroupsAndModifier(uRollExprResult))
-	result.AppendMessagePart(fmt.Sprintf(
-		"%d/%d (最大/合計)",
+	result.AppendMessagePart(evaluator.Message(
+		locale.MAX_AND_SUM,
 		uRollExprResult.MaxValue().Value,
 		uRollExprResult.SumOfValues().Value,
 	))
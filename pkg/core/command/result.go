@@ -1,6 +1,7 @@
 package command
 
 import (
+	"fmt"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"strings"
 )
@@ -15,13 +16,28 @@ const (
 	SUCCESS_CHECK_SUCCESS
 	// 成功判定結果：失敗
 	SUCCESS_CHECK_FAILURE
+	// 成功判定結果：クリティカル（決定的成功）
+	SUCCESS_CHECK_CRITICAL
+	// 成功判定結果：ファンブル（致命的失敗）
+	SUCCESS_CHECK_FUMBLE
+	// 成功判定結果：スペシャル
+	SUCCESS_CHECK_SPECIAL
+	// 成功判定結果：自動的成功
+	SUCCESS_CHECK_AUTO_SUCCESS
+	// 成功判定結果：自動的失敗
+	SUCCESS_CHECK_AUTO_FAILURE
 )
 
 // 成功判定結果の文字列表現
 var successCheckResultString = map[SuccessCheckResultType]string{
-	SUCCESS_CHECK_UNSPECIFIED: "UNSPECIFIED",
-	SUCCESS_CHECK_SUCCESS:     "SUCCESS",
-	SUCCESS_CHECK_FAILURE:     "FAILURE",
+	SUCCESS_CHECK_UNSPECIFIED:  "UNSPECIFIED",
+	SUCCESS_CHECK_SUCCESS:      "SUCCESS",
+	SUCCESS_CHECK_FAILURE:      "FAILURE",
+	SUCCESS_CHECK_CRITICAL:     "CRITICAL",
+	SUCCESS_CHECK_FUMBLE:       "FUMBLE",
+	SUCCESS_CHECK_SPECIAL:      "SPECIAL",
+	SUCCESS_CHECK_AUTO_SUCCESS: "AUTO_SUCCESS",
+	SUCCESS_CHECK_AUTO_FAILURE: "AUTO_FAILURE",
 }
 
 // String は成功判定結果を文字列として返す。
@@ -33,28 +49,146 @@ func (r SuccessCheckResultType) String() string {
 	return "UNKNOWN"
 }
 
+// IsSuccess は、成功判定結果が成功の一種であるかを返す。
+func (r SuccessCheckResultType) IsSuccess() bool {
+	switch r {
+	case SUCCESS_CHECK_SUCCESS,
+		SUCCESS_CHECK_CRITICAL,
+		SUCCESS_CHECK_SPECIAL,
+		SUCCESS_CHECK_AUTO_SUCCESS:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFailure は、成功判定結果が失敗の一種であるかを返す。
+func (r SuccessCheckResultType) IsFailure() bool {
+	switch r {
+	case SUCCESS_CHECK_FAILURE,
+		SUCCESS_CHECK_FUMBLE,
+		SUCCESS_CHECK_AUTO_FAILURE:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalText は成功判定結果をテキストに変換する。
+// JSONでは "SUCCESS" のような文字列として表される。
+func (r SuccessCheckResultType) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText はテキストを成功判定結果に変換する。
+func (r *SuccessCheckResultType) UnmarshalText(text []byte) error {
+	for t, s := range successCheckResultString {
+		if s == string(text) {
+			*r = t
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown success check result: %q", text)
+}
+
 // コマンドの実行結果の構造体
 type Result struct {
 	// ゲーム識別子
-	GameID string
+	GameID string `json:"gameId"`
 	// メッセージの部分の配列
-	MessageParts []string
+	MessageParts []string `json:"messageParts"`
 	// 振られたダイス
-	RolledDice []dice.Die
+	RolledDice []dice.Die `json:"rolledDice"`
 	// 成功判定の結果
-	SuccessCheckResult SuccessCheckResultType
+	SuccessCheckResult SuccessCheckResultType `json:"successCheckResult"`
 	// シークレットロールかどうか
-	IsSecret bool
+	IsSecret bool `json:"secret"`
+	// 構造化された実行結果の詳細。
+	// 基本コマンド以外では設定されない場合がある。
+	Detail *Detail `json:"detail,omitempty"`
+	// 繰り返しコマンドにおける各回の実行結果。
+	// 繰り返しコマンド以外では設定されない。
+	Results []*Result `json:"results,omitempty"`
+}
+
+// NewRepeatResult は、繰り返しコマンドの各回の実行結果resultsをまとめた実行結果を返す。
+//
+// 振られたダイスは各回のものを順に連結する。
+// メッセージは各回のメッセージを改行で区切って結合したものとなる。
+func NewRepeatResult(gameID string, results []*Result) *Result {
+	rolledDice := []dice.Die{}
+	for _, r := range results {
+		rolledDice = append(rolledDice, r.RolledDice...)
+	}
+
+	return &Result{
+		GameID:       gameID,
+		MessageParts: []string{},
+		RolledDice:   rolledDice,
+		Results:      results,
+	}
+}
+
+// Clone は実行結果のコピーを返す。
+//
+// 詳細および繰り返しコマンドの各回の実行結果も含めて、元の実行結果と
+// 値を共有しないようにコピーする。
+func (r *Result) Clone() *Result {
+	if r == nil {
+		return nil
+	}
+
+	copiedResult := *r
+	copiedResult.MessageParts = append([]string{}, r.MessageParts...)
+	copiedResult.RolledDice = append([]dice.Die{}, r.RolledDice...)
+	copiedResult.Detail = r.Detail.Clone()
+
+	if r.Results != nil {
+		copiedResult.Results = make([]*Result, 0, len(r.Results))
+		for _, result := range r.Results {
+			copiedResult.Results = append(copiedResult.Results, result.Clone())
+		}
+	}
+
+	return &copiedResult
+}
+
+// IsRepeat は、繰り返しコマンドの実行結果かどうかを返す。
+func (r *Result) IsRepeat() bool {
+	return len(r.Results) > 0
 }
 
 // JoinedMessageParts は、メッセージの部分を結合したものを返す。
+//
+// 繰り返しコマンドの実行結果では、各回のメッセージの部分を結合したものを改行で区切って返す。
 func (r *Result) JoinedMessageParts() string {
-	return strings.Join(r.MessageParts, " ＞ ")
+	if !r.IsRepeat() {
+		return strings.Join(r.MessageParts, " ＞ ")
+	}
+
+	messages := make([]string, 0, len(r.Results))
+	for _, result := range r.Results {
+		messages = append(messages, result.JoinedMessageParts())
+	}
+
+	return strings.Join(messages, "\n")
 }
 
 // Message はコマンドの応答メッセージを返す。
+//
+// 繰り返しコマンドの実行結果では、各回の応答メッセージを改行で区切って返す。
 func (r *Result) Message() string {
-	return r.GameID + " : " + r.JoinedMessageParts()
+	if !r.IsRepeat() {
+		return r.GameID + " : " + r.JoinedMessageParts()
+	}
+
+	messages := make([]string, 0, len(r.Results))
+	for _, result := range r.Results {
+		messages = append(messages, result.Message())
+	}
+
+	return strings.Join(messages, "\n")
 }
 
 // AppendMessagePart はメッセージの部分を追加する。
@@ -0,0 +1,93 @@
+package command
+
+import (
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"reflect"
+	"testing"
+)
+
+func TestSuccessCheckResultType(t *testing.T) {
+	testcases := []struct {
+		r         SuccessCheckResultType
+		str       string
+		isSuccess bool
+		isFailure bool
+	}{
+		{SUCCESS_CHECK_UNSPECIFIED, "UNSPECIFIED", false, false},
+		{SUCCESS_CHECK_SUCCESS, "SUCCESS", true, false},
+		{SUCCESS_CHECK_FAILURE, "FAILURE", false, true},
+		{SUCCESS_CHECK_CRITICAL, "CRITICAL", true, false},
+		{SUCCESS_CHECK_FUMBLE, "FUMBLE", false, true},
+		{SUCCESS_CHECK_SPECIAL, "SPECIAL", true, false},
+		{SUCCESS_CHECK_AUTO_SUCCESS, "AUTO_SUCCESS", true, false},
+		{SUCCESS_CHECK_AUTO_FAILURE, "AUTO_FAILURE", false, true},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.str, func(t *testing.T) {
+			if actual := test.r.String(); actual != test.str {
+				t.Errorf("String(): got: %q, want: %q", actual, test.str)
+			}
+
+			if actual := test.r.IsSuccess(); actual != test.isSuccess {
+				t.Errorf("IsSuccess(): got: %t, want: %t", actual, test.isSuccess)
+			}
+
+			if actual := test.r.IsFailure(); actual != test.isFailure {
+				t.Errorf("IsFailure(): got: %t, want: %t", actual, test.isFailure)
+			}
+
+			var decoded SuccessCheckResultType
+			if err := decoded.UnmarshalText([]byte(test.str)); err != nil {
+				t.Fatalf("got err: %s", err)
+			}
+
+			if decoded != test.r {
+				t.Errorf("UnmarshalText(): got: %s, want: %s", decoded, test.r)
+			}
+		})
+	}
+}
+
+func TestSuccessCheckResultType_UnmarshalUnknownText(t *testing.T) {
+	var r SuccessCheckResultType
+	if err := r.UnmarshalText([]byte("UNKNOWN")); err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+}
+
+func TestNewRepeatResult(t *testing.T) {
+	results := []*Result{
+		{
+			GameID:       "DiceBot",
+			MessageParts: []string{"(2D6)", "7[3,4]", "7"},
+			RolledDice:   []dice.Die{{3, 6}, {4, 6}},
+		},
+		{
+			GameID:       "DiceBot",
+			MessageParts: []string{"(2D6)", "3[1,2]", "3"},
+			RolledDice:   []dice.Die{{1, 6}, {2, 6}},
+		},
+	}
+
+	r := NewRepeatResult("DiceBot", results)
+
+	if !r.IsRepeat() {
+		t.Error("繰り返しコマンドの実行結果になっていない")
+	}
+
+	expectedMessage := "DiceBot : (2D6) ＞ 7[3,4] ＞ 7\nDiceBot : (2D6) ＞ 3[1,2] ＞ 3"
+	if actual := r.Message(); actual != expectedMessage {
+		t.Errorf("Message(): got: %q, want: %q", actual, expectedMessage)
+	}
+
+	expectedJoined := "(2D6) ＞ 7[3,4] ＞ 7\n(2D6) ＞ 3[1,2] ＞ 3"
+	if actual := r.JoinedMessageParts(); actual != expectedJoined {
+		t.Errorf("JoinedMessageParts(): got: %q, want: %q", actual, expectedJoined)
+	}
+
+	expectedDice := []dice.Die{{3, 6}, {4, 6}, {1, 6}, {2, 6}}
+	if !reflect.DeepEqual(r.RolledDice, expectedDice) {
+		t.Errorf("RolledDice: got: %v, want: %v", r.RolledDice, expectedDice)
+	}
+}
@@ -5,15 +5,17 @@ package dice
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
 // ダイスを表す構造体。
 type Die struct {
 	// 出目
-	Value int
+	Value int `json:"value"`
 	// ダイスの面の数
-	Sides int
+	Sides int `json:"sides"`
 }
 
 // String はダイスの文字列表現を返す。
@@ -50,3 +52,31 @@ func FormatDiceWithoutSpaces(dice []Die) string {
 
 	return strings.Join(dieStrs, ",")
 }
+
+// ダイス表記 "値/面数" を表す正規表現
+var dieNotationRe = regexp.MustCompile(`\A\s*(\d+)/(\d+)\s*\z`)
+
+// ParseDice は "値/面数,値/面数,..." という形式の文字列を解析し、ダイス列を返す。
+// FormatDice および FormatDiceWithoutSpaces の結果を解析することができる。
+// 空文字列の場合は空のダイス列を返す。
+func ParseDice(source string) ([]Die, error) {
+	ds := []Die{}
+
+	if strings.TrimSpace(source) == "" {
+		return ds, nil
+	}
+
+	dieStrs := strings.Split(source, ",")
+	for i, dieStr := range dieStrs {
+		matches := dieNotationRe.FindStringSubmatch(dieStr)
+		if matches == nil {
+			return nil, fmt.Errorf("ParseDice: #%d: %q: ダイス構文エラー", i+1, dieStr)
+		}
+
+		value, _ := strconv.Atoi(matches[1])
+		sides, _ := strconv.Atoi(matches[2])
+		ds = append(ds, Die{Value: value, Sides: sides})
+	}
+
+	return ds, nil
+}
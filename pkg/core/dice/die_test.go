@@ -2,6 +2,7 @@ package dice
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -96,3 +97,40 @@ func TestFormatDiceWithoutSpace(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDice(t *testing.T) {
+	testcases := []struct {
+		source   string
+		expected []Die
+		err      bool
+	}{
+		{source: "", expected: []Die{}},
+		{source: "2/6", expected: []Die{{2, 6}}},
+		{source: "1/6,3/6,57/100", expected: []Die{{1, 6}, {3, 6}, {57, 100}}},
+		{source: "2/4, 3/6, 5/10, 10/20", expected: []Die{{2, 4}, {3, 6}, {5, 10}, {10, 20}}},
+		{source: "1/6,", err: true},
+		{source: "a/6", err: true},
+		{source: "1-6", err: true},
+	}
+
+	for _, test := range testcases {
+		t.Run(fmt.Sprintf("%q", test.source), func(t *testing.T) {
+			actual, err := ParseDice(test.source)
+			if err != nil {
+				if !test.err {
+					t.Fatalf("got err: %s", err)
+				}
+				return
+			}
+
+			if test.err {
+				t.Fatal("should err")
+				return
+			}
+
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("got %v, want %v", actual, test.expected)
+			}
+		})
+	}
+}
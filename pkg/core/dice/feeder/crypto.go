@@ -0,0 +1,62 @@
+package feeder
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+)
+
+// 暗号論的擬似乱数生成器を使用してダイスを取り出すダイス供給機の構造体。
+//
+// シードを推測されないため、公開されたダイスボットでの使用に適している。
+// 出目は面の数によらず一様に分布する。
+type Crypto struct {
+	reader io.Reader
+}
+
+// CryptoがFeederインターフェースを実装しているかの確認
+var _ DieFeeder = (*Crypto)(nil)
+
+// NewCrypto は、crypto/randを使用するダイス供給機を返す。
+func NewCrypto() *Crypto {
+	return NewCryptoWithReader(rand.Reader)
+}
+
+// NewCryptoWithReader は、乱数源を指定したダイス供給機を返す。
+//
+// r: 乱数源。通常は crypto/rand.Reader を指定する。
+func NewCryptoWithReader(r io.Reader) *Crypto {
+	return &Crypto{
+		reader: r,
+	}
+}
+
+// CanSpecifyDie は、供給されるダイスを指定できるかを返す。
+// Cryptoダイス供給機ではfalseを返す。
+func (f *Crypto) CanSpecifyDie() bool {
+	return false
+}
+
+// Next はランダムな値のダイスを1つ供給する。
+//
+// sides: ダイスの面の数
+func (f *Crypto) Next(sides int) (dice.Die, error) {
+	if sides < 1 {
+		return dice.Die{}, fmt.Errorf("ダイスの面の数が不正です: %d", sides)
+	}
+
+	// rand.Int は棄却サンプリングを行うため、偏りは生じない
+	n, err := rand.Int(f.reader, big.NewInt(int64(sides)))
+	if err != nil {
+		return dice.Die{}, err
+	}
+
+	d := dice.Die{
+		Sides: sides,
+		Value: 1 + int(n.Int64()),
+	}
+	return d, nil
+}
@@ -0,0 +1,76 @@
+package feeder
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+)
+
+func TestCrypto_CanSpecifyDie(t *testing.T) {
+	f := NewCrypto()
+
+	if f.CanSpecifyDie() {
+		t.Fatalf("Cryptoはダイスを指定できてはならない")
+	}
+}
+
+func TestCrypto_Next(t *testing.T) {
+	testcases := []int{1, 2, 3, 6, 10, 20, 100, 1000}
+
+	for _, sides := range testcases {
+		t.Run(fmt.Sprintf("%d", sides), func(t *testing.T) {
+			f := NewCrypto()
+
+			for i := 0; i < 100; i++ {
+				d, err := f.Next(sides)
+				if err != nil {
+					t.Fatalf("got err: %s", err)
+					return
+				}
+
+				if d.Sides != sides || d.Value < 1 || d.Value > sides {
+					t.Fatalf("wrong die: %s", d)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestCrypto_Next_ShouldRejectOutOfRangeValues(t *testing.T) {
+	// 6面ダイスでは下位3ビットが使われる。
+	// 0x07（7）は範囲外のため棄却され、次の0x03（3）から出目4が得られる。
+	f := NewCryptoWithReader(bytes.NewReader([]byte{0x07, 0x03}))
+
+	actual, err := f.Next(6)
+	if err != nil {
+		t.Fatalf("got err: %s", err)
+		return
+	}
+
+	expected := dice.Die{4, 6}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("wrong die: got %s, want %s", actual, expected)
+	}
+}
+
+func TestCrypto_Next_Error(t *testing.T) {
+	t.Run("面の数が0", func(t *testing.T) {
+		f := NewCrypto()
+
+		if _, err := f.Next(0); err == nil {
+			t.Fatal("エラーが発生しませんでした")
+		}
+	})
+
+	t.Run("乱数源が空", func(t *testing.T) {
+		f := NewCryptoWithReader(bytes.NewReader([]byte{}))
+
+		if _, err := f.Next(6); err == nil {
+			t.Fatal("エラーが発生しませんでした")
+		}
+	})
+}
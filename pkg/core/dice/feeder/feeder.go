@@ -3,7 +3,10 @@
 このパッケージに含まれる構造体を利用することで、ダイスの値をランダムにするか、指定したものにするかを切り替えることができる。
 
 ダイスの値をランダムにする場合は、MT19937を使用する。
+シードを推測されてはならない場合は、Cryptoを使用する。
+内部状態を保存、復元する必要がある場合は、PCGを使用する。
 ダイスの値を指定したものにする場合は、Queueを使用する。
+ダイス供給機を複数のゴルーチンで共有する場合は、Synchronizedで包む。
 */
 package feeder
 
@@ -0,0 +1,145 @@
+package feeder
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+)
+
+// PCG32の乗数
+const pcgMultiplier uint64 = 6364136223846793005
+
+// PCGState はPCGダイス供給機の内部状態を表す構造体。
+//
+// 保存した内部状態からダイス供給機を復元すると、
+// 保存した時点から同じ順番でダイスが供給される。
+type PCGState struct {
+	// 状態
+	State uint64 `json:"state"`
+	// 増分（系列を決める奇数）
+	Inc uint64 `json:"inc"`
+}
+
+// String は内部状態を "状態:増分" の16進数表記で返す。
+func (s PCGState) String() string {
+	return fmt.Sprintf("%016x:%016x", s.State, s.Inc)
+}
+
+// ParsePCGState は "状態:増分" の16進数表記から内部状態を読み込む。
+func ParsePCGState(s string) (PCGState, error) {
+	var state PCGState
+
+	n, err := fmt.Sscanf(s, "%016x:%016x", &state.State, &state.Inc)
+	if err != nil || n != 2 {
+		return PCGState{}, fmt.Errorf("PCGの内部状態の表記が不正です: %q", s)
+	}
+
+	if state.Inc&1 == 0 {
+		return PCGState{}, fmt.Errorf("PCGの増分が奇数ではありません: %q", s)
+	}
+
+	return state, nil
+}
+
+// PCG（PCG32、XSH-RR）を使用してランダムにダイスを取り出すダイス供給機の構造体。
+//
+// 内部状態を保存、復元できるため、セッションの再開や出目の検証に使用できる。
+type PCG struct {
+	state PCGState
+}
+
+// PCGがFeederインターフェースを実装しているかの確認
+var _ DieFeeder = (*PCG)(nil)
+
+// NewPCG は、シードと系列番号を指定したPCGダイス供給機を返す。
+//
+// seed: シード,
+// seq: 系列番号。
+func NewPCG(seed uint64, seq uint64) *PCG {
+	f := &PCG{
+		state: PCGState{
+			State: 0,
+			Inc:   (seq << 1) | 1,
+		},
+	}
+
+	f.next32()
+	f.state.State += seed
+	f.next32()
+
+	return f
+}
+
+// NewPCGWithSeedFromCrypto は、crypto/randから得たシードと系列番号を使用するPCGダイス供給機を返す。
+func NewPCGWithSeedFromCrypto() (*PCG, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+
+	return NewPCG(binary.LittleEndian.Uint64(b[:8]), binary.LittleEndian.Uint64(b[8:])), nil
+}
+
+// NewPCGFromState は、保存した内部状態からPCGダイス供給機を復元する。
+// 増分が奇数でなければエラーを返す。
+func NewPCGFromState(state PCGState) (*PCG, error) {
+	if state.Inc&1 == 0 {
+		return nil, fmt.Errorf("PCGの増分が奇数ではありません: %s", state)
+	}
+
+	return &PCG{state: state}, nil
+}
+
+// CanSpecifyDie は、供給されるダイスを指定できるかを返す。
+// PCGダイス供給機ではfalseを返す。
+func (f *PCG) CanSpecifyDie() bool {
+	return false
+}
+
+// State は現在の内部状態を返す。
+func (f *PCG) State() PCGState {
+	return f.state
+}
+
+// Next はランダムな値のダイスを1つ供給する。
+//
+// sides: ダイスの面の数
+func (f *PCG) Next(sides int) (dice.Die, error) {
+	if sides < 1 || uint64(sides) > math.MaxUint32 {
+		return dice.Die{}, fmt.Errorf("ダイスの面の数が不正です: %d", sides)
+	}
+
+	d := dice.Die{
+		Sides: sides,
+		Value: 1 + int(f.bounded(uint32(sides))),
+	}
+	return d, nil
+}
+
+// next32 は32ビットの乱数を1つ生成する。
+func (f *PCG) next32() uint32 {
+	old := f.state.State
+	f.state.State = old*pcgMultiplier + f.state.Inc
+
+	xorShifted := uint32(((old >> 18) ^ old) >> 27)
+	rot := uint32(old >> 59)
+
+	return (xorShifted >> rot) | (xorShifted << ((-rot) & 31))
+}
+
+// bounded は0以上bound未満の一様な乱数を生成する。
+//
+// 偏りが生じないよう、範囲外の値は棄却する。
+func (f *PCG) bounded(bound uint32) uint32 {
+	threshold := -bound % bound
+
+	for {
+		r := f.next32()
+		if r >= threshold {
+			return r % bound
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package feeder
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPCG_CanSpecifyDie(t *testing.T) {
+	f := NewPCG(42, 54)
+
+	if f.CanSpecifyDie() {
+		t.Fatalf("PCGはダイスを指定できてはならない")
+	}
+}
+
+func TestPCG_next32(t *testing.T) {
+	// PCG32の参照実装（pcg32-demo）においてシード42、系列番号54の場合に得られる値
+	expected := []uint32{
+		0xa15c02b7, 0x7b47f409, 0xba1d3330, 0x83d2f293, 0xbfa4784b, 0xcbed606e,
+	}
+
+	f := NewPCG(42, 54)
+	for i, e := range expected {
+		if actual := f.next32(); actual != e {
+			t.Errorf("#%d: got 0x%08x, want 0x%08x", i, actual, e)
+		}
+	}
+}
+
+func TestPCG_Next(t *testing.T) {
+	testcases := []int{1, 2, 3, 6, 10, 20, 100, 1000}
+
+	for _, sides := range testcases {
+		t.Run(fmt.Sprintf("%d", sides), func(t *testing.T) {
+			f := NewPCG(20190401, 1)
+
+			for i := 0; i < 100; i++ {
+				d, err := f.Next(sides)
+				if err != nil {
+					t.Fatalf("got err: %s", err)
+					return
+				}
+
+				if d.Sides != sides || d.Value < 1 || d.Value > sides {
+					t.Fatalf("wrong die: %s", d)
+					return
+				}
+			}
+		})
+	}
+}
+
+func TestPCG_Next_Error(t *testing.T) {
+	f := NewPCG(42, 54)
+
+	if _, err := f.Next(0); err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+}
+
+func TestPCG_State_ShouldRestoreSequence(t *testing.T) {
+	f := NewPCG(42, 54)
+
+	// 途中まで進めてから内部状態を保存する
+	for i := 0; i < 10; i++ {
+		f.Next(6)
+	}
+
+	saved := f.State()
+	restored, err := NewPCGFromState(saved)
+	if err != nil {
+		t.Fatalf("got err: %s", err)
+		return
+	}
+
+	for i := 0; i < 20; i++ {
+		expected, _ := f.Next(100)
+		actual, _ := restored.Next(100)
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("#%d: got %s, want %s", i, actual, expected)
+			return
+		}
+	}
+}
+
+func TestNewPCGFromState_Error(t *testing.T) {
+	if _, err := NewPCGFromState(PCGState{State: 1, Inc: 2}); err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+}
+
+func TestNewPCGWithSeedFromCrypto(t *testing.T) {
+	f1, err1 := NewPCGWithSeedFromCrypto()
+	if err1 != nil {
+		t.Fatalf("got err: %s", err1)
+		return
+	}
+
+	f2, err2 := NewPCGWithSeedFromCrypto()
+	if err2 != nil {
+		t.Fatalf("got err: %s", err2)
+		return
+	}
+
+	if f1.State() == f2.State() {
+		t.Error("異なるダイス供給機の内部状態が一致した")
+	}
+}
+
+func TestPCGState_String(t *testing.T) {
+	state := PCGState{State: 0x0123456789abcdef, Inc: 0x6d}
+
+	expected := "0123456789abcdef:000000000000006d"
+	if actual := state.String(); actual != expected {
+		t.Errorf("got %q, want %q", actual, expected)
+	}
+}
+
+func TestParsePCGState(t *testing.T) {
+	t.Run("正しい表記", func(t *testing.T) {
+		expected := NewPCG(42, 54).State()
+
+		actual, err := ParsePCGState(expected.String())
+		if err != nil {
+			t.Fatalf("got err: %s", err)
+			return
+		}
+
+		if actual != expected {
+			t.Errorf("got %s, want %s", actual, expected)
+		}
+	})
+
+	testcases := []string{
+		"",
+		"0123",
+		"0123456789abcdef:000000000000006c",
+		"xyz:abc",
+	}
+
+	for _, s := range testcases {
+		t.Run(fmt.Sprintf("%q", s), func(t *testing.T) {
+			if _, err := ParsePCGState(s); err == nil {
+				t.Fatal("エラーが発生しませんでした")
+			}
+		})
+	}
+}
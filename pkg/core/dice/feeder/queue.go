@@ -1,10 +1,13 @@
 package feeder
 
 import (
-	"fmt"
+	"errors"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
 )
 
+// ErrExhausted は、ダイス供給機から取り出せるダイスがなくなったことを表すエラー。
+var ErrExhausted = errors.New("取り出せるダイスがありません")
+
 // 指定したダイスを取り出せる、キュー型ダイス供給機の構造体。
 type Queue struct {
 	queue []dice.Die
@@ -43,10 +46,10 @@ func (f *Queue) Dice() []dice.Die {
 }
 
 // Next はキューからダイスを1つ取り出して供給する。
-// キューが空だった場合はエラーを返す。
+// キューが空だった場合は ErrExhausted を返す。
 func (f *Queue) Next(_ int) (dice.Die, error) {
 	if f.IsEmpty() {
-		return dice.Die{}, fmt.Errorf("取り出せるダイスがありません")
+		return dice.Die{}, ErrExhausted
 	}
 
 	// キューからダイスを取り出す
@@ -1,6 +1,7 @@
 package feeder
 
 import (
+	"errors"
 	"fmt"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"reflect"
@@ -102,6 +103,19 @@ func TestQueue_Next(t *testing.T) {
 	}
 }
 
+func TestQueue_Next_Exhausted(t *testing.T) {
+	f := NewQueue([]dice.Die{{1, 6}})
+
+	if _, err := f.Next(6); err != nil {
+		t.Fatalf("エラー: %s", err)
+	}
+
+	_, err := f.Next(6)
+	if !errors.Is(err, ErrExhausted) {
+		t.Fatalf("ErrExhaustedが返されない: %v", err)
+	}
+}
+
 func TestQueue_Append(t *testing.T) {
 	ds := []dice.Die{{1, 6}, {2, 6}, {3, 6}, {4, 6}, {5, 6}, {6, 6}}
 
@@ -0,0 +1,126 @@
+package feeder
+
+import (
+	"sync"
+	"time"
+
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+)
+
+// Record はダイス供給の記録を表す構造体。
+type Record struct {
+	// ダイスが供給された時刻
+	Time time.Time
+	// 要求されたダイスの面の数
+	Sides int
+	// 供給されたダイス
+	Die dice.Die
+}
+
+// 他のダイス供給機を包み、供給したダイスを記録するダイス供給機の構造体。
+//
+// 記録は "値/面数,値/面数,..." という形式で出力できる。
+// 出力した記録を NewReplayQueue に渡すと、同じ順番でダイスを供給するキューが得られる。
+type Recorder struct {
+	// 包んでいるダイス供給機
+	feeder DieFeeder
+	// 現在時刻を返す関数
+	now func() time.Time
+
+	// 記録を保護するためのミューテックス
+	mu sync.Mutex
+	// 供給の記録
+	records []Record
+}
+
+// RecorderがFeederインターフェースを実装しているかの確認
+var _ DieFeeder = (*Recorder)(nil)
+
+// NewRecorder は、ダイス供給機fを包んで記録するダイス供給機を返す。
+func NewRecorder(f DieFeeder) *Recorder {
+	return &Recorder{
+		feeder:  f,
+		now:     time.Now,
+		records: []Record{},
+	}
+}
+
+// CanSpecifyDie は、供給されるダイスを指定できるかを返す。
+// 包んでいるダイス供給機の結果をそのまま返す。
+func (r *Recorder) CanSpecifyDie() bool {
+	return r.feeder.CanSpecifyDie()
+}
+
+// Feeder は包んでいるダイス供給機を返す。
+func (r *Recorder) Feeder() DieFeeder {
+	return r.feeder
+}
+
+// Next は、包んでいるダイス供給機からダイスを1つ取り出して供給し、記録する。
+// ダイスの供給に失敗した場合は記録しない。
+//
+// sides: ダイスの面の数
+func (r *Recorder) Next(sides int) (dice.Die, error) {
+	d, err := r.feeder.Next(sides)
+	if err != nil {
+		return dice.Die{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.records = append(r.records, Record{
+		Time:  r.now(),
+		Sides: sides,
+		Die:   d,
+	})
+
+	return d, nil
+}
+
+// Records は供給の記録をコピーして返す。
+func (r *Recorder) Records() []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	copiedRecords := make([]Record, len(r.records))
+	copy(copiedRecords, r.records)
+
+	return copiedRecords
+}
+
+// Dice は供給したダイスを順に並べたスライスを返す。
+func (r *Recorder) Dice() []dice.Die {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ds := make([]dice.Die, 0, len(r.records))
+	for _, rec := range r.records {
+		ds = append(ds, rec.Die)
+	}
+
+	return ds
+}
+
+// String は供給の記録を "値/面数,値/面数,..." という形式で返す。
+func (r *Recorder) String() string {
+	return dice.FormatDiceWithoutSpaces(r.Dice())
+}
+
+// Reset は供給の記録を消去する。
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.records = []Record{}
+}
+
+// NewReplayQueue は、記録 "値/面数,値/面数,..." を再生するキュー型ダイス供給機を返す。
+func NewReplayQueue(log string) (*Queue, error) {
+	ds, err := dice.ParseDice(log)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQueue(ds), nil
+}
@@ -0,0 +1,99 @@
+package feeder
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+)
+
+func TestRecorder_CanSpecifyDie(t *testing.T) {
+	if !NewRecorder(NewEmptyQueue()).CanSpecifyDie() {
+		t.Error("Queueを包んだRecorderはダイスを指定できなければならない")
+	}
+
+	if NewRecorder(NewMT19937(1)).CanSpecifyDie() {
+		t.Error("MT19937を包んだRecorderはダイスを指定できてはならない")
+	}
+}
+
+func TestRecorder_Next(t *testing.T) {
+	ds := []dice.Die{{1, 6}, {3, 6}, {57, 100}}
+	f := NewRecorder(NewQueue(ds))
+
+	baseTime := time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)
+	count := 0
+	f.now = func() time.Time {
+		count++
+		return baseTime.Add(time.Duration(count) * time.Second)
+	}
+
+	for _, expected := range ds {
+		actual, err := f.Next(expected.Sides)
+		if err != nil {
+			t.Fatalf("got err: %s", err)
+			return
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("wrong die: got %s, want %s", actual, expected)
+		}
+	}
+
+	// キューが空のため失敗し、記録されない
+	if _, err := f.Next(6); err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+
+	expectedRecords := []Record{
+		{Time: baseTime.Add(1 * time.Second), Sides: 6, Die: dice.Die{1, 6}},
+		{Time: baseTime.Add(2 * time.Second), Sides: 6, Die: dice.Die{3, 6}},
+		{Time: baseTime.Add(3 * time.Second), Sides: 100, Die: dice.Die{57, 100}},
+	}
+	if actual := f.Records(); !reflect.DeepEqual(actual, expectedRecords) {
+		t.Errorf("wrong records: got %+v, want %+v", actual, expectedRecords)
+	}
+
+	if actual := f.String(); actual != "1/6,3/6,57/100" {
+		t.Errorf("wrong log: got %q, want %q", actual, "1/6,3/6,57/100")
+	}
+}
+
+func TestRecorder_Reset(t *testing.T) {
+	f := NewRecorder(NewQueue([]dice.Die{{1, 6}}))
+	f.Next(6)
+	f.Reset()
+
+	if len(f.Records()) != 0 {
+		t.Errorf("記録が消去されていない: %+v", f.Records())
+	}
+}
+
+func TestRecorder_ShouldBeReplayable(t *testing.T) {
+	r := NewRecorder(NewMT19937(1))
+
+	sides := []int{6, 6, 10, 100, 20, 4}
+	for _, s := range sides {
+		if _, err := r.Next(s); err != nil {
+			t.Fatalf("got err: %s", err)
+			return
+		}
+	}
+
+	q, err := NewReplayQueue(r.String())
+	if err != nil {
+		t.Fatalf("got err: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(q.Dice(), r.Dice()) {
+		t.Errorf("再生されるダイスが異なる: got %v, want %v", q.Dice(), r.Dice())
+	}
+}
+
+func TestNewReplayQueue_Error(t *testing.T) {
+	if _, err := NewReplayQueue("1/6,x/6"); err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+}
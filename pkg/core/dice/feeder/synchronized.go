@@ -0,0 +1,57 @@
+package feeder
+
+import (
+	"sync"
+
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+)
+
+// 他のダイス供給機を包み、複数のゴルーチンから安全に使えるようにするダイス供給機の構造体。
+//
+// MT19937やQueueは排他制御を行わないため、複数のゴルーチンで共有する場合はこれで包む。
+type Synchronized struct {
+	// 包んでいるダイス供給機を保護するためのミューテックス
+	mu sync.Mutex
+	// 包んでいるダイス供給機
+	feeder DieFeeder
+}
+
+// SynchronizedがFeederインターフェースを実装しているかの確認
+var _ DieFeeder = (*Synchronized)(nil)
+
+// NewSynchronized は、ダイス供給機fを包んで排他制御を行うダイス供給機を返す。
+//
+// fがすでにSynchronizedであった場合は、fをそのまま返す。
+func NewSynchronized(f DieFeeder) *Synchronized {
+	if s, ok := f.(*Synchronized); ok {
+		return s
+	}
+
+	return &Synchronized{
+		feeder: f,
+	}
+}
+
+// CanSpecifyDie は、供給されるダイスを指定できるかを返す。
+// 包んでいるダイス供給機の結果をそのまま返す。
+func (s *Synchronized) CanSpecifyDie() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.feeder.CanSpecifyDie()
+}
+
+// Feeder は包んでいるダイス供給機を返す。
+func (s *Synchronized) Feeder() DieFeeder {
+	return s.feeder
+}
+
+// Next は、排他制御を行いながら、包んでいるダイス供給機からダイスを1つ取り出して供給する。
+//
+// sides: ダイスの面の数
+func (s *Synchronized) Next(sides int) (dice.Die, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.feeder.Next(sides)
+}
@@ -0,0 +1,68 @@
+package feeder
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+)
+
+func TestSynchronized_CanSpecifyDie(t *testing.T) {
+	if !NewSynchronized(NewEmptyQueue()).CanSpecifyDie() {
+		t.Error("Queueを包んだSynchronizedはダイスを指定できなければならない")
+	}
+
+	if NewSynchronized(NewMT19937(1)).CanSpecifyDie() {
+		t.Error("MT19937を包んだSynchronizedはダイスを指定できてはならない")
+	}
+}
+
+func TestNewSynchronized_ShouldNotWrapTwice(t *testing.T) {
+	s := NewSynchronized(NewMT19937(1))
+
+	if NewSynchronized(s) != s {
+		t.Error("Synchronizedが二重に包まれた")
+	}
+}
+
+func TestSynchronized_NextConcurrently(t *testing.T) {
+	const numOfGoroutines = 16
+	const numOfDicePerGoroutine = 100
+
+	ds := make([]dice.Die, 0, numOfGoroutines*numOfDicePerGoroutine)
+	for i := 0; i < numOfGoroutines*numOfDicePerGoroutine; i++ {
+		ds = append(ds, dice.Die{i%6 + 1, 6})
+	}
+
+	f := NewSynchronized(NewQueue(ds))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, numOfGoroutines)
+
+	for i := 0; i < numOfGoroutines; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < numOfDicePerGoroutine; j++ {
+				if _, err := f.Next(6); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("got err: %s", err)
+	}
+
+	// すべてのダイスがちょうど1回ずつ取り出されていれば、キューは空になる
+	if _, err := f.Next(6); err == nil {
+		t.Error("キューが空になっていない")
+	}
+}
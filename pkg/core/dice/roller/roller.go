@@ -8,23 +8,43 @@ import (
 	"fmt"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
 )
 
 // ダイスローラーを表す構造体。
 type DiceRoller struct {
 	// feederが実際にダイスを供給する
 	feeder feeder.DieFeeder
+	// 1回に振るダイスの数と面数の上限
+	limits limits.Limits
 }
 
 // New は指定したDieFeederを使うDiceRollerを構築して返す。
+// 上限には既定のものが設定される。
 func New(f feeder.DieFeeder) *DiceRoller {
+	return NewWithLimits(f, limits.Default())
+}
+
+// NewWithLimits は、指定したDieFeederと上限lを使うDiceRollerを構築して返す。
+func NewWithLimits(f feeder.DieFeeder, l limits.Limits) *DiceRoller {
 	dr := &DiceRoller{
 		feeder: f,
+		limits: l,
 	}
 
 	return dr
 }
 
+// Limits は設定されている上限を返す。
+func (dr *DiceRoller) Limits() limits.Limits {
+	return dr.limits
+}
+
+// SetLimits は上限をlに設定する。
+func (dr *DiceRoller) SetLimits(l limits.Limits) {
+	dr.limits = l
+}
+
 // DieFeeder は指定したDieFeederを返す。
 func (dr *DiceRoller) DieFeeder() feeder.DieFeeder {
 	return dr.feeder
@@ -34,6 +54,7 @@ func (dr *DiceRoller) DieFeeder() feeder.DieFeeder {
 //
 // num、sidesともに正の整数でなければならない。
 // この条件が満たされていなかった場合は、エラーを返す。
+// また、num、sidesが上限を超えていた場合は *limits.ExceededError を返す。
 func (dr *DiceRoller) RollDice(num int, sides int) ([]dice.Die, error) {
 	if sides < 1 {
 		return nil, fmt.Errorf(
@@ -51,6 +72,10 @@ func (dr *DiceRoller) RollDice(num int, sides int) ([]dice.Die, error) {
 		)
 	}
 
+	if err := dr.limits.CheckRoll(num, sides); err != nil {
+		return nil, err
+	}
+
 	// 結果のスライスの領域をnum個分確保する
 	rolledDice := make([]dice.Die, 0, num)
 
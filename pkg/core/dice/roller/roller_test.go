@@ -1,8 +1,10 @@
 package roller
 
 import (
+	"errors"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
 	"reflect"
 	"testing"
 )
@@ -138,3 +140,51 @@ func TestDiceRoller_RollDice_MT19937(t *testing.T) {
 		}
 	}
 }
+
+func TestDiceRoller_RollDice_Limits(t *testing.T) {
+	l := limits.Limits{
+		MaxDicePerRoll: 10,
+		MaxSides:       100,
+	}
+
+	testcases := []struct {
+		num   int
+		sides int
+		// 期待する上限の種類（エラーを期待しない場合は-1）
+		kind limits.Kind
+	}{
+		{10, 100, -1},
+		{11, 6, limits.DICE_PER_ROLL},
+		{99999999, 99999999, limits.DICE_PER_ROLL},
+		{1, 101, limits.SIDES},
+	}
+
+	for i, test := range testcases {
+		dr := NewWithLimits(feeder.NewMT19937(1), l)
+
+		rolledDice, err := dr.RollDice(test.num, test.sides)
+		if test.kind < 0 {
+			if err != nil {
+				t.Errorf("#%d: got err: %s", i, err)
+				continue
+			}
+
+			if len(rolledDice) != test.num {
+				t.Errorf("#%d: wrong number of dice: got %d dice, want %d dice",
+					i, len(rolledDice), test.num)
+			}
+
+			continue
+		}
+
+		var exceeded *limits.ExceededError
+		if !errors.As(err, &exceeded) {
+			t.Errorf("#%d: limit error expected: got %v", i, err)
+			continue
+		}
+
+		if exceeded.Kind != test.kind {
+			t.Errorf("#%d: wrong kind: got %s, want %s", i, exceeded.Kind, test.kind)
+		}
+	}
+}
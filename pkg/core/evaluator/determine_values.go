@@ -3,6 +3,9 @@ package evaluator
 import (
 	"fmt"
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
 
 // DetermneValuesは、可変ノードの値を決定する
@@ -20,8 +23,15 @@ func (e *Evaluator) DetermineValues(node ast.Node) error {
 }
 
 func (e *Evaluator) determineValueOfVariableExpr(node ast.Node) (ast.Node, error) {
-	if node.Type() == ast.D_ROLL_NODE {
+	switch node.Type() {
+	case ast.D_ROLL_NODE:
 		return e.determineValueOfDRoll(node.(*ast.VariableInfixExpression))
+	case ast.KEEP_HIGHEST_NODE, ast.KEEP_LOWEST_NODE, ast.DROP_HIGHEST_NODE, ast.DROP_LOWEST_NODE:
+		return e.determineValueOfKeepDrop(node.(*ast.VariableInfixExpression))
+	case ast.EXPLODE_NODE, ast.COMPOUND_EXPLODE_NODE:
+		return e.determineValueOfExplode(node.(*ast.VariableInfixExpression))
+	case ast.REROLL_NODE, ast.REROLL_ONCE_NODE:
+		return e.determineValueOfReroll(node.(*ast.Reroll))
 	}
 
 	return nil, fmt.Errorf("determineValueOfVariableExpr not implemented: %s", node.Type())
@@ -51,6 +61,176 @@ func (e *Evaluator) determineValueOfDRoll(
 	return ast.NewSumRollResult(rolledDice), nil
 }
 
+// determineValueOfReroll は振り直しつき加算ロールの値を決定する。
+func (e *Evaluator) determineValueOfReroll(node *ast.Reroll) (*ast.SumRollResult, error) {
+	dRoll, dRollIsVarInfix := node.Left().(*ast.VariableInfixExpression)
+	if !dRollIsVarInfix || dRoll.Type() != ast.D_ROLL_NODE {
+		return nil, fmt.Errorf("not DRoll: %s", node.Left().Type())
+	}
+
+	num, numIsInt := dRoll.Left().(*ast.Int)
+	if !numIsInt {
+		return nil, fmt.Errorf("num is not Int: %s", dRoll.Left().Type())
+	}
+
+	sides, sidesIsInt := dRoll.Right().(*ast.Int)
+	if !sidesIsInt {
+		return nil, fmt.Errorf("sides is not Int: %s", dRoll.Right().Type())
+	}
+
+	threshold, thresholdIsInt := node.Right().(*ast.Int)
+	if !thresholdIsInt {
+		return nil, fmt.Errorf("threshold is not Int: %s", node.Right().Type())
+	}
+
+	return e.rollDiceWithRerolls(
+		num.Value,
+		sides.Value,
+		node.CompareOperator,
+		threshold.Value,
+		node.Once(),
+	)
+}
+
+// evalReroll は振り直しつき加算ロールを評価する。
+func (e *Evaluator) evalReroll(node *ast.Reroll) (object.Object, error) {
+	dRoll, dRollIsInfix := node.Left().(ast.InfixExpression)
+	if !dRollIsInfix || dRoll.Type() != ast.D_ROLL_NODE {
+		return nil, fmt.Errorf("not DRoll: %s", node.Left().Type())
+	}
+
+	num, sides, operandsErr := e.evalInfixExpressionOperands(dRoll)
+	if operandsErr != nil {
+		return nil, operandsErr
+	}
+
+	threshold, thresholdErr := e.Eval(node.Right())
+	if thresholdErr != nil {
+		return nil, thresholdErr
+	}
+
+	result, rollErr := e.rollDiceWithRerolls(
+		num.(*object.Integer).Value,
+		sides.(*object.Integer).Value,
+		node.CompareOperator,
+		threshold.(*object.Integer).Value,
+		node.Once(),
+	)
+	if rollErr != nil {
+		return nil, rollErr
+	}
+
+	return object.NewInteger(result.Value()), nil
+}
+
+// rollDiceWithRerolls は、num個のsides面ダイスを振り、条件を満たす出目のダイスを振り直す。
+//
+// 振り直されたダイスは捨てられたものとして記録され、
+// 振り直したダイスはその直後に振り足されたものとして並ぶ。
+// onceがtrueの場合は1個のダイスにつき1回だけ振り直す。
+// そうでなければ、条件を満たさなくなるまで、最大振り足し数を上限として振り直す。
+func (e *Evaluator) rollDiceWithRerolls(
+	num int,
+	sides int,
+	compareOperator string,
+	threshold int,
+	once bool,
+) (*ast.SumRollResult, error) {
+	matches := func(value int) (bool, error) {
+		r, err := e.evalIntegerInfixExpression(
+			compareOperator,
+			object.NewInteger(value),
+			object.NewInteger(threshold),
+		)
+		if err != nil {
+			return false, err
+		}
+
+		return r.(*object.Boolean).Value, nil
+	}
+
+	// 振り直しの条件を確かめる前に、振ることができるダイスかを確認する
+	if err := e.Limits.CheckRoll(num, sides); err != nil {
+		return nil, err
+	}
+
+	if !once {
+		// すべての出目が条件を満たす場合は振り直しが終わらない。
+		// 条件を満たす出目の範囲は区間またはその補集合なので、
+		// 1、閾値付近および最大の出目だけを確かめれば十分である
+		alwaysMet := true
+		candidates := []int{1, threshold - 1, threshold, threshold + 1, sides}
+		for _, v := range candidates {
+			if v < 1 || v > sides {
+				continue
+			}
+
+			m, err := matches(v)
+			if err != nil {
+				return nil, err
+			}
+
+			if !m {
+				alwaysMet = false
+				break
+			}
+		}
+
+		if alwaysMet {
+			return nil, &InvalidThresholdError{
+				Specified: true,
+				Value:     threshold,
+				message:   e.Message(locale.REROLL_CONDITION_ALWAYS_MET),
+			}
+		}
+	}
+
+	initialDice, rollErr := e.RollDice(num, sides)
+	if rollErr != nil {
+		return nil, rollErr
+	}
+
+	rolledDice := make([]dice.Die, 0, len(initialDice))
+	rerolled := make([]bool, 0, len(initialDice))
+	// 振り直されたダイスの添字
+	indicesToDrop := []int{}
+
+	for _, d := range initialDice {
+		rolledDice = append(rolledDice, d)
+		rerolled = append(rerolled, false)
+
+		value := d.Value
+		for j := 0; (once && j < 1) || (!once && e.canReroll(j)); j++ {
+			m, err := matches(value)
+			if err != nil {
+				return nil, err
+			}
+
+			if !m {
+				break
+			}
+
+			rerolledDice, err := e.RollDice(1, sides)
+			if err != nil {
+				return nil, err
+			}
+
+			indicesToDrop = append(indicesToDrop, len(rolledDice)-1)
+			rolledDice = append(rolledDice, rerolledDice[0])
+			rerolled = append(rerolled, true)
+
+			value = rerolledDice[0].Value
+		}
+	}
+
+	result := ast.NewSumRollResultWithRerolls(rolledDice, rerolled, false)
+	for _, i := range indicesToDrop {
+		result.Drop(i)
+	}
+
+	return result, nil
+}
+
 type nodeSetter func(ast.Node)
 
 func (e *Evaluator) replaceVariablePrimaryExpr(node ast.Node, setter nodeSetter) error {
@@ -144,6 +144,86 @@ func TestDetermineValues(t *testing.T) {
 			expected: "(DRollExpr (/R (SumRollResult (Die 54 100)) 10))",
 			dice:     []dice.Die{{54, 100}},
 		},
+		{
+			input:    "4D6KH3",
+			expected: "(DRollExpr (SumRollResult (Die 3 6) (Dropped (Die 1 6)) (Die 6 6) (Die 4 6)))",
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "2D20KL1",
+			expected: "(DRollExpr (SumRollResult (Dropped (Die 15 20)) (Die 8 20)))",
+			dice:     []dice.Die{{15, 20}, {8, 20}},
+		},
+		{
+			input:    "4D6DH1",
+			expected: "(DRollExpr (SumRollResult (Die 3 6) (Die 1 6) (Dropped (Die 6 6)) (Die 4 6)))",
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "4D6DL1",
+			expected: "(DRollExpr (SumRollResult (Die 3 6) (Dropped (Die 1 6)) (Die 6 6) (Die 4 6)))",
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "4D6DL2",
+			expected: "(DRollExpr (SumRollResult (Dropped (Die 2 6)) (Dropped (Die 2 6)) (Die 2 6) (Die 5 6)))",
+			dice:     []dice.Die{{2, 6}, {2, 6}, {2, 6}, {5, 6}},
+		},
+		{
+			input:    "4D6DH2",
+			expected: "(DRollExpr (SumRollResult (Die 5 6) (Die 2 6) (Dropped (Die 5 6)) (Dropped (Die 5 6))))",
+			dice:     []dice.Die{{5, 6}, {2, 6}, {5, 6}, {5, 6}},
+		},
+		{
+			input:    "2D6KH3",
+			expected: "(DRollExpr (SumRollResult (Die 2 6) (Die 5 6)))",
+			dice:     []dice.Die{{2, 6}, {5, 6}},
+		},
+		{
+			input:    "2D6DL3",
+			expected: "(DRollExpr (SumRollResult (Dropped (Die 2 6)) (Dropped (Die 5 6))))",
+			dice:     []dice.Die{{2, 6}, {5, 6}},
+		},
+		{
+			input:    "4D6KH3+1",
+			expected: "(DRollExpr (+ (SumRollResult (Die 3 6) (Dropped (Die 1 6)) (Die 6 6) (Die 4 6)) 1))",
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "1D6!",
+			expected: "(DRollExpr (SumRollResult (Die 6 6) (Rerolled (Die 6 6)) (Rerolled (Die 3 6))))",
+			dice:     []dice.Die{{6, 6}, {6, 6}, {3, 6}},
+		},
+		{
+			input:    "2D6!",
+			expected: "(DRollExpr (SumRollResult (Die 6 6) (Rerolled (Die 2 6)) (Die 4 6)))",
+			dice:     []dice.Die{{6, 6}, {4, 6}, {2, 6}},
+		},
+		{
+			input:    "2D6!>=5",
+			expected: "(DRollExpr (SumRollResult (Die 5 6) (Rerolled (Die 1 6)) (Die 3 6)))",
+			dice:     []dice.Die{{5, 6}, {3, 6}, {1, 6}},
+		},
+		{
+			input:    "2D6r1",
+			expected: "(DRollExpr (SumRollResult (Dropped (Die 1 6)) (Dropped (Rerolled (Die 1 6))) (Rerolled (Die 3 6)) (Die 4 6)))",
+			dice:     []dice.Die{{1, 6}, {4, 6}, {1, 6}, {3, 6}},
+		},
+		{
+			input:    "2D6ro1",
+			expected: "(DRollExpr (SumRollResult (Dropped (Die 1 6)) (Rerolled (Die 1 6)) (Die 4 6)))",
+			dice:     []dice.Die{{1, 6}, {4, 6}, {1, 6}},
+		},
+		{
+			input:    "4D6ro<3",
+			expected: "(DRollExpr (SumRollResult (Die 5 6) (Dropped (Die 2 6)) (Rerolled (Die 6 6)) (Die 3 6) (Dropped (Die 1 6)) (Rerolled (Die 2 6))))",
+			dice:     []dice.Die{{5, 6}, {2, 6}, {3, 6}, {1, 6}, {6, 6}, {2, 6}},
+		},
+		{
+			input:    "2D6!!+1",
+			expected: "(DRollExpr (+ (SumRollResult (Die 2 6) (Die 6 6) (Rerolled (Die 4 6))) 1))",
+			dice:     []dice.Die{{2, 6}, {6, 6}, {4, 6}},
+		},
 	}
 
 	for _, test := range testcases {
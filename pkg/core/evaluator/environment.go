@@ -7,15 +7,54 @@ import (
 // コマンド評価の環境を表す構造体。
 type Environment struct {
 	rolledDice []dice.Die
+	// 変数とマクロを保持するスコープ
+	scope *Scope
 }
 
-// NewEnvironment は新しいコマンド評価環境を返す。
+// NewEnvironment は、新しい空のスコープを持つコマンド評価環境を返す。
 func NewEnvironment() *Environment {
+	return NewEnvironmentWithScope(NewScope())
+}
+
+// NewEnvironmentWithScope は、スコープscopeを使用する新しいコマンド評価環境を返す。
+//
+// 複数のコマンドの実行で同じスコープを使用すると、変数とマクロを共有することができる。
+func NewEnvironmentWithScope(scope *Scope) *Environment {
 	return &Environment{
 		rolledDice: []dice.Die{},
+		scope:      scope,
 	}
 }
 
+// Scope は変数とマクロを保持するスコープを返す。
+func (e *Environment) Scope() *Scope {
+	return e.scope
+}
+
+// Variable は変数nameの値を返す。
+// 変数が定義されていない場合、2番目の返り値はfalseとなる。
+func (e *Environment) Variable(name string) (int, bool) {
+	return e.scope.Variable(name)
+}
+
+// SetVariable は変数nameに値valueを代入する。
+// 変数とマクロの合計数が上限を超える場合は *limits.ExceededError を返す。
+func (e *Environment) SetVariable(name string, value int) error {
+	return e.scope.SetVariable(name, value)
+}
+
+// Macro はマクロnameの本体を返す。
+// マクロが定義されていない場合、2番目の返り値はfalseとなる。
+func (e *Environment) Macro(name string) (string, bool) {
+	return e.scope.Macro(name)
+}
+
+// SetMacro はマクロnameを本体bodyで定義する。
+// 変数とマクロの合計数が上限を超える場合は *limits.ExceededError を返す。
+func (e *Environment) SetMacro(name string, body string) error {
+	return e.scope.SetMacro(name, body)
+}
+
 // RolledDice は記録されたダイスロール結果を返す。
 func (e *Environment) RolledDice() []dice.Die {
 	// ダイスロール結果のコピー先
@@ -25,6 +64,11 @@ func (e *Environment) RolledDice() []dice.Die {
 	return dice
 }
 
+// NumOfRolledDice は記録されたダイスの数を返す。
+func (e *Environment) NumOfRolledDice() int {
+	return len(e.rolledDice)
+}
+
 // PushRolledDie は振られたダイスを記録に追加する。
 func (e *Environment) PushRolledDie(d dice.Die) {
 	e.rolledDice = append(e.rolledDice, d)
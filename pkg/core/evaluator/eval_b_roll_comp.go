@@ -2,6 +2,7 @@ package evaluator
 
 import (
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
 
@@ -9,6 +10,22 @@ import (
 func (e *Evaluator) evalBRollComp(node *ast.Command) (*object.BRollCompResult, error) {
 	compareNode := node.Expression.(*ast.BasicInfixExpression)
 
+	bRollList, isBRollList := compareNode.Left().(*ast.BRollList)
+	hasBotchThreshold := isBRollList &&
+		bRollList.BotchThreshold != nil &&
+		!bRollList.BotchThreshold.IsNil()
+
+	var botchThreshold *object.Integer
+	if hasBotchThreshold {
+		// ダイスを振る前にボッチの閾値を確認する
+		t, checkErr := e.evalBotchThreshold(compareNode, bRollList.BotchThreshold)
+		if checkErr != nil {
+			return nil, checkErr
+		}
+
+		botchThreshold = t
+	}
+
 	// 両辺を評価する
 	valuesObj, targetObj, evalOperandsErr :=
 		e.evalInfixExpressionOperands(compareNode)
@@ -28,5 +45,73 @@ func (e *Evaluator) evalBRollComp(node *ast.Command) (*object.BRollCompResult, e
 		return nil, countErr
 	}
 
-	return object.NewBRollCompResult(valuesArray, numOfSuccesses), nil
+	if !hasBotchThreshold {
+		return object.NewBRollCompResult(valuesArray, numOfSuccesses), nil
+	}
+
+	// ボッチの数を数える
+	numOfBotches, countBotchesErr := e.countNumOfSuccesses(
+		valuesArray,
+		"<=",
+		botchThreshold,
+	)
+	if countBotchesErr != nil {
+		return nil, countBotchesErr
+	}
+
+	return object.NewBRollCompResultWithBotches(
+		valuesArray,
+		numOfSuccesses,
+		numOfBotches,
+	), nil
+}
+
+// evalBotchThreshold はボッチの閾値を評価する。
+//
+// ボッチの閾値以下の出目のうち、成功となるものがある場合は
+// *InvalidThresholdError を返す（例："2B6>=1[1]"）。
+func (e *Evaluator) evalBotchThreshold(
+	compareNode *ast.BasicInfixExpression,
+	botchThresholdNode ast.Node,
+) (*object.Integer, error) {
+	botchThresholdObj, evalBotchThresholdErr := e.Eval(botchThresholdNode)
+	if evalBotchThresholdErr != nil {
+		return nil, evalBotchThresholdErr
+	}
+
+	targetObj, evalTargetErr := e.Eval(compareNode.Right())
+	if evalTargetErr != nil {
+		return nil, evalTargetErr
+	}
+
+	botchThreshold := botchThresholdObj.(*object.Integer)
+	target := targetObj.(*object.Integer).Value
+
+	// 成功となる出目の範囲は区間またはその補集合なので、
+	// 1、閾値付近および上限だけを確かめれば重なりがわかる
+	candidates := []int{1, target - 1, target, target + 1, botchThreshold.Value}
+	for _, v := range candidates {
+		if v < 1 || v > botchThreshold.Value {
+			continue
+		}
+
+		r, err := e.evalIntegerInfixExpression(
+			compareNode.Operator(),
+			object.NewInteger(v),
+			targetObj.(*object.Integer),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.(*object.Boolean).Value {
+			return nil, &InvalidThresholdError{
+				Specified: true,
+				Value:     botchThreshold.Value,
+				message:   e.Message(locale.BOTCH_THRESHOLD_OVERLAPS_SUCCESS),
+			}
+		}
+	}
+
+	return botchThreshold, nil
 }
@@ -1,6 +1,7 @@
 package evaluator
 
 import (
+	"errors"
 	"fmt"
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
@@ -177,3 +178,116 @@ func TestEvalBRollComp(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalBRollComp_Botch(t *testing.T) {
+	testcases := []struct {
+		input                  string
+		expectedNumOfSuccesses int
+		expectedNumOfBotches   int
+		dice                   []dice.Die
+	}{
+		{
+			input:                  "4b10>=8[1]",
+			expectedNumOfSuccesses: 2,
+			expectedNumOfBotches:   1,
+			dice:                   []dice.Die{{8, 10}, {1, 10}, {10, 10}, {5, 10}},
+		},
+		{
+			input:                  "4b10>=8[2]",
+			expectedNumOfSuccesses: 0,
+			expectedNumOfBotches:   3,
+			dice:                   []dice.Die{{1, 10}, {2, 10}, {1, 10}, {7, 10}},
+		},
+		{
+			input:                  "3b6>=5[0+1]",
+			expectedNumOfSuccesses: 1,
+			expectedNumOfBotches:   0,
+			dice:                   []dice.Die{{5, 6}, {2, 6}, {3, 6}},
+		},
+	}
+
+	for _, test := range testcases {
+		name := fmt.Sprintf("%q[%s]",
+			test.input, dice.FormatDiceWithoutSpaces(test.dice))
+		t.Run(name, func(t *testing.T) {
+			r, parseErr := parser.Parse("test", []byte(test.input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+				return
+			}
+
+			// ノードを評価する
+			dieFeeder := feeder.NewQueue(test.dice)
+			evaluator := NewEvaluator(roller.New(dieFeeder), NewEnvironment())
+
+			evaluated, evalErr := evaluator.Eval(r.(ast.Node))
+			if evalErr != nil {
+				t.Fatalf("評価エラー: %s", evalErr)
+				return
+			}
+
+			obj, typeMatched := evaluated.(*object.BRollCompResult)
+			if !typeMatched {
+				t.Fatalf("BRollCompResultでない: %T (%+v)", evaluated, evaluated)
+				return
+			}
+
+			if !obj.HasBotches() {
+				t.Fatal("ボッチの数が記録されていない")
+				return
+			}
+
+			actualNumOfSuccesses := obj.NumOfSuccesses.Value
+			if actualNumOfSuccesses != test.expectedNumOfSuccesses {
+				t.Errorf("異なる成功数: got=%d, want=%d",
+					actualNumOfSuccesses, test.expectedNumOfSuccesses)
+			}
+
+			actualNumOfBotches := obj.NumOfBotches.Value
+			if actualNumOfBotches != test.expectedNumOfBotches {
+				t.Errorf("異なるボッチの数: got=%d, want=%d",
+					actualNumOfBotches, test.expectedNumOfBotches)
+			}
+		})
+	}
+}
+
+func TestEvalBRollComp_BotchThresholdOverlapsSuccess(t *testing.T) {
+	testcases := []string{
+		"2b6>=1[1]",
+		"2b6>=3[3]",
+		"2b6>3[6]",
+		"2b6<=4[1]",
+		"2b6<2[1]",
+		"2b6=2[2]",
+		"2b6<>1[2]",
+	}
+
+	for _, input := range testcases {
+		t.Run(fmt.Sprintf("%q", input), func(t *testing.T) {
+			r, parseErr := parser.Parse("test", []byte(input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+				return
+			}
+
+			dieFeeder := feeder.NewQueue([]dice.Die{{1, 6}, {1, 6}})
+			evaluator := NewEvaluator(roller.New(dieFeeder), NewEnvironment())
+
+			_, evalErr := evaluator.Eval(r.(ast.Node))
+			if evalErr == nil {
+				t.Fatal("エラーが発生しませんでした")
+				return
+			}
+
+			var thresholdErr *InvalidThresholdError
+			if !errors.As(evalErr, &thresholdErr) {
+				t.Errorf("InvalidThresholdErrorではない: %T (%s)", evalErr, evalErr)
+			}
+
+			if n := len(evaluator.RolledDice()); n != 0 {
+				t.Errorf("ダイスが振られた: %d個", n)
+			}
+		})
+	}
+}
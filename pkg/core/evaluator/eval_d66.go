@@ -0,0 +1,38 @@
+package evaluator
+
+import (
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+)
+
+// evalD66 はD66ダイスを評価する。
+//
+// 2個の6面ダイスを振り、並べ方に従って十の位と一の位に割り当てる。
+// 並べ方が指定されていない場合は、評価器に設定されている既定の並べ方を使う。
+func (e *Evaluator) evalD66(node *ast.D66) (*object.Integer, error) {
+	rolledDice, err := e.RollDice(2, 6)
+	if err != nil {
+		return nil, err
+	}
+
+	tens := rolledDice[0].Value
+	ones := rolledDice[1].Value
+
+	order := node.Order
+	if order == ast.D66_ORDER_UNSPECIFIED {
+		order = e.DefaultD66Order
+	}
+
+	switch order {
+	case ast.D66_ORDER_ASCENDING:
+		if tens > ones {
+			tens, ones = ones, tens
+		}
+	case ast.D66_ORDER_DESCENDING:
+		if tens < ones {
+			tens, ones = ones, tens
+		}
+	}
+
+	return object.NewInteger(tens*10 + ones), nil
+}
@@ -0,0 +1,119 @@
+package evaluator
+
+import (
+	"fmt"
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+	"github.com/raa0121/GoBCDice/pkg/core/parser"
+	"reflect"
+	"testing"
+)
+
+func TestEvalD66(t *testing.T) {
+	testcases := []struct {
+		input        string
+		defaultOrder ast.D66Order
+		expected     int
+		dice         []dice.Die
+	}{
+		{
+			input:        "D66",
+			defaultOrder: ast.D66_ORDER_NONE,
+			expected:     35,
+			dice:         []dice.Die{{3, 6}, {5, 6}},
+		},
+		{
+			input:        "D66",
+			defaultOrder: ast.D66_ORDER_NONE,
+			expected:     53,
+			dice:         []dice.Die{{5, 6}, {3, 6}},
+		},
+		{
+			input:        "D66",
+			defaultOrder: ast.D66_ORDER_ASCENDING,
+			expected:     35,
+			dice:         []dice.Die{{5, 6}, {3, 6}},
+		},
+		{
+			input:        "D66",
+			defaultOrder: ast.D66_ORDER_DESCENDING,
+			expected:     53,
+			dice:         []dice.Die{{3, 6}, {5, 6}},
+		},
+		{
+			input:        "D66",
+			defaultOrder: ast.D66_ORDER_DESCENDING,
+			expected:     44,
+			dice:         []dice.Die{{4, 6}, {4, 6}},
+		},
+		{
+			input:        "D66N",
+			defaultOrder: ast.D66_ORDER_ASCENDING,
+			expected:     61,
+			dice:         []dice.Die{{6, 6}, {1, 6}},
+		},
+		{
+			input:        "D66S",
+			defaultOrder: ast.D66_ORDER_NONE,
+			expected:     16,
+			dice:         []dice.Die{{6, 6}, {1, 6}},
+		},
+		{
+			input:        "D66S",
+			defaultOrder: ast.D66_ORDER_DESCENDING,
+			expected:     26,
+			dice:         []dice.Die{{2, 6}, {6, 6}},
+		},
+	}
+
+	for _, test := range testcases {
+		name := fmt.Sprintf("%q(%s)[%s]",
+			test.input, test.defaultOrder, dice.FormatDiceWithoutSpaces(test.dice))
+		t.Run(name, func(t *testing.T) {
+			r, parseErr := parser.Parse("test", []byte(test.input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+				return
+			}
+
+			node := r.(ast.Node)
+
+			// ノードを評価する
+			dieFeeder := feeder.NewQueue(test.dice)
+			evaluator := NewEvaluator(roller.New(dieFeeder), NewEnvironment())
+			evaluator.DefaultD66Order = test.defaultOrder
+
+			evaluated, evalErr := evaluator.Eval(node)
+			if evalErr != nil {
+				t.Fatalf("評価エラー: %s", evalErr)
+				return
+			}
+
+			if evaluated == nil {
+				t.Fatalf("Evalの対象外 (nil)")
+				return
+			}
+
+			// 型が合っているか？
+			obj, typeMatched := evaluated.(*object.Integer)
+			if !typeMatched {
+				t.Fatalf("整数オブジェクトでない: %T (%+v)", evaluated, evaluated)
+				return
+			}
+
+			actual := obj.Value
+			if actual != test.expected {
+				t.Errorf("異なる値: got=%d, want=%d", actual, test.expected)
+			}
+
+			rolledDice := evaluator.RolledDice()
+			if !reflect.DeepEqual(rolledDice, test.dice) {
+				t.Errorf("異なるダイスロール結果記録: got=%v, want=%v",
+					rolledDice, test.dice)
+			}
+		})
+	}
+}
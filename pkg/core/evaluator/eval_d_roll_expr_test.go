@@ -1,6 +1,7 @@
 package evaluator
 
 import (
+	"errors"
 	"fmt"
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
@@ -178,6 +179,31 @@ func TestEvalDRollExpr(t *testing.T) {
 			expected: 13,
 			dice:     []dice.Die{{2, 4}, {3, 3}, {5, 6}, {5, 6}, {4, 6}},
 		},
+		{
+			input:    "4D6KH3",
+			expected: 13,
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "2D20KL1+2",
+			expected: 10,
+			dice:     []dice.Die{{15, 20}, {8, 20}},
+		},
+		{
+			input:    "4D6DH1",
+			expected: 8,
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "4D6DL1",
+			expected: 13,
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
+		{
+			input:    "(2+2)D6KH(1+1)",
+			expected: 10,
+			dice:     []dice.Die{{3, 6}, {1, 6}, {6, 6}, {4, 6}},
+		},
 	}
 
 	for _, test := range testcases {
@@ -226,3 +252,39 @@ func TestEvalDRollExpr(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalDRollExpr_InvalidExplodeThreshold(t *testing.T) {
+	testcases := []string{
+		"1D1!",
+		"2D6!>=1",
+		"2D6!!>=0",
+		"2D6!>=10",
+		"2D6!!>=7",
+		"2D6r<7",
+		"1D1r1",
+	}
+
+	for _, input := range testcases {
+		t.Run(fmt.Sprintf("%q", input), func(t *testing.T) {
+			r, parseErr := parser.Parse("test", []byte(input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+				return
+			}
+
+			dieFeeder := feeder.NewQueue([]dice.Die{{1, 1}, {1, 6}, {1, 6}})
+			evaluator := NewEvaluator(roller.New(dieFeeder), NewEnvironment())
+
+			_, evalErr := evaluator.Eval(r.(ast.Node))
+			if evalErr == nil {
+				t.Fatal("エラーが発生しませんでした")
+				return
+			}
+
+			var thresholdErr *InvalidThresholdError
+			if !errors.As(evalErr, &thresholdErr) {
+				t.Errorf("InvalidThresholdErrorではない: %T (%s)", evalErr, evalErr)
+			}
+		})
+	}
+}
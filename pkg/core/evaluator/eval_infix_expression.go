@@ -12,6 +12,18 @@ import (
 func (e *Evaluator) evalInfixExpression(
 	node ast.InfixExpression,
 ) (object.Object, error) {
+	if isKeepDropNode(node) {
+		return e.evalKeepDrop(node)
+	}
+
+	if isExplodeNode(node) {
+		return e.evalExplode(node)
+	}
+
+	if reroll, isReroll := node.(*ast.Reroll); isReroll {
+		return e.evalReroll(reroll)
+	}
+
 	left, right, err := e.evalInfixExpressionOperands(node)
 	if err != nil {
 		return nil, err
@@ -31,7 +31,7 @@ func (e *Evaluator) evalRRollList(node *ast.RRollList) (*object.Array, error) {
 
 	// ダイスロール結果を格納する配列
 	valueGroups := []object.Object{}
-	for i := 0; i < e.MaxRerolls && len(rollQueue) > 0; i++ {
+	for i := 0; e.canReroll(i) && len(rollQueue) > 0; i++ {
 		// キューの最初のダイスロールを取り出す
 		rRoll := rollQueue[0]
 		if len(rollQueue) < 2 {
@@ -104,7 +104,7 @@ func (e *Evaluator) evalCompoundingRoll(
 	for i := 0; i < numVal; i++ {
 		valueGroup := []object.Object{}
 
-		for j := 0; j < e.MaxRerolls; j++ {
+		for j := 0; e.canReroll(j); j++ {
 			rolledDice, err := e.RollDice(1, sidesVal)
 			if err != nil {
 				return nil, err
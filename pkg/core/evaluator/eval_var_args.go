@@ -38,6 +38,12 @@ func (e *Evaluator) evalVarArgsOfVariableExpr(node ast.Node) error {
 	switch node.Type() {
 	case ast.D_ROLL_NODE, ast.B_ROLL_NODE, ast.R_ROLL_NODE, ast.U_ROLL_NODE:
 		return e.evalVarArgsOfRoll(node.(ast.InfixExpression))
+	case ast.KEEP_HIGHEST_NODE, ast.KEEP_LOWEST_NODE, ast.DROP_HIGHEST_NODE, ast.DROP_LOWEST_NODE:
+		return e.evalVarArgsOfKeepDrop(node.(ast.InfixExpression))
+	case ast.EXPLODE_NODE, ast.COMPOUND_EXPLODE_NODE:
+		return e.evalVarArgsOfExplode(node.(ast.InfixExpression))
+	case ast.REROLL_NODE, ast.REROLL_ONCE_NODE:
+		return e.evalVarArgsOfReroll(node.(*ast.Reroll))
 	}
 
 	return fmt.Errorf("evalVarArgsOfVariableExpr not implemented: %s", node.Type())
@@ -61,8 +67,52 @@ func (e *Evaluator) evalVarArgsOfRoll(node ast.InfixExpression) error {
 	return nil
 }
 
+// evalVarArgsOfKeepDrop は出目を残す/捨てる加算ロールのノードの引数を評価して整数に変換する。
+func (e *Evaluator) evalVarArgsOfKeepDrop(node ast.InfixExpression) error {
+	dRollErr := e.evalVarArgsOfRoll(node.Left().(ast.InfixExpression))
+	if dRollErr != nil {
+		return dRollErr
+	}
+
+	countObj, countErr := e.Eval(node.Right())
+	if countErr != nil {
+		return countErr
+	}
+
+	node.SetRight(objectToIntNode(countObj))
+
+	return nil
+}
+
+// evalVarArgsOfReroll は振り直しつき加算ロールのノードの引数を評価して整数に変換する。
+func (e *Evaluator) evalVarArgsOfReroll(node *ast.Reroll) error {
+	dRollErr := e.evalVarArgsOfRoll(node.Left().(ast.InfixExpression))
+	if dRollErr != nil {
+		return dRollErr
+	}
+
+	thresholdObj, thresholdErr := e.Eval(node.Right())
+	if thresholdErr != nil {
+		return thresholdErr
+	}
+
+	node.SetRight(objectToIntNode(thresholdObj))
+
+	return nil
+}
+
 // evalVarArgsInBRollList はバラバラロール列内の可変ノードの引数を評価して整数に変換する。
 func (e *Evaluator) evalVarArgsInBRollList(node *ast.BRollList) error {
+	// ボッチの閾値を評価する
+	if node.BotchThreshold != nil && !node.BotchThreshold.IsNil() {
+		botchThresholdObj, err := e.Eval(node.BotchThreshold)
+		if err != nil {
+			return err
+		}
+
+		node.BotchThreshold = objectToIntNode(botchThresholdObj)
+	}
+
 	for _, b := range node.BRolls {
 		err := e.evalVarArgsOfVariableExpr(b)
 		if err != nil {
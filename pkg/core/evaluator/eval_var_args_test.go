@@ -77,6 +77,17 @@ func TestEvalVarArgs(t *testing.T) {
 			expected: "(DRollExpr (+ (* (DRoll 1 4) (DRoll 2 6)) (* 2 3)))",
 		},
 
+		// 出目を残す/捨てる加算ロール
+		{
+			input:    "(2+2)D6KH(1+2)",
+			expected: "(DRollExpr (KeepHighest (DRoll 4 6) 3))",
+		},
+		{
+			input:    "2D20KL[1...2]",
+			expected: "(DRollExpr (KeepLowest (DRoll 2 20) 1))",
+			dice:     []dice.Die{{1, 2}},
+		},
+
 		// ランダム数値埋め込み
 		{
 			input:    "[1...5]D6",
@@ -18,6 +18,8 @@ import (
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
 
@@ -25,9 +27,15 @@ import (
 type Evaluator struct {
 	diceRoller *roller.DiceRoller
 	env        *Environment
-	// 個数振り足しロールにおける最大振り足し数
-	// TODO: 外部から変更するためのインターフェースを作る
-	MaxRerolls int
+	// 振るダイスの合計数や振り足し数などの上限
+	Limits limits.Limits
+	// 同じメッセージの実行において、この評価器より前に振られたダイスの数。
+	// 繰り返しコマンドで、振るダイスの合計数の上限を各回で共有するために使う。
+	PriorRolledDice int
+	// D66ダイスにおいて並べ方が指定されていない場合の並べ方
+	DefaultD66Order ast.D66Order
+	// 結果のメッセージの言語
+	Locale locale.Locale
 }
 
 // NewEvaluator は新しい評価器を返す。
@@ -36,12 +44,19 @@ type Evaluator struct {
 // env: 評価環境
 func NewEvaluator(diceRoller *roller.DiceRoller, env *Environment) *Evaluator {
 	return &Evaluator{
-		diceRoller: diceRoller,
-		env:        env,
-		MaxRerolls: 10000,
+		diceRoller:      diceRoller,
+		env:             env,
+		Limits:          limits.Default(),
+		DefaultD66Order: ast.D66_ORDER_NONE,
+		Locale:          locale.DEFAULT,
 	}
 }
 
+// Message は、設定されている言語でメッセージIDがidのメッセージを整形して返す。
+func (e *Evaluator) Message(id locale.MessageID, args ...interface{}) string {
+	return e.Locale.Sprintf(id, args...)
+}
+
 // RolledDice はダイスロール結果を返す。
 func (e *Evaluator) RolledDice() []dice.Die {
 	return e.env.RolledDice()
@@ -59,6 +74,8 @@ func (e *Evaluator) Eval(node ast.Node) (object.Object, error) {
 		return e.evalURollExpr(n)
 	case *ast.Choice:
 		return e.evalChoice(n)
+	case *ast.D66:
+		return e.evalD66(n)
 	case *ast.Command:
 		return e.evalCommand(n)
 	case ast.PrefixExpression:
@@ -67,6 +84,8 @@ func (e *Evaluator) Eval(node ast.Node) (object.Object, error) {
 		return e.evalInfixExpression(n)
 	case *ast.Int:
 		return object.NewInteger(n.Value), nil
+	case *ast.VariableRef:
+		return e.evalVariableRef(n)
 	case *ast.SumRollResult:
 		return object.NewInteger(n.Value()), nil
 	}
@@ -90,7 +109,17 @@ func (e *Evaluator) evalCommand(node *ast.Command) (object.Object, error) {
 
 // RollDice は、sides個の面を持つダイスをnum個振り、その結果を返す。
 // また、ダイスロールの結果を記録する。
+//
+// 振るダイスの数や面数、振ったダイスの合計数が上限を超える場合は *limits.ExceededError を返す。
 func (e *Evaluator) RollDice(num int, sides int) ([]dice.Die, error) {
+	if err := e.Limits.CheckRoll(num, sides); err != nil {
+		return nil, err
+	}
+
+	if err := e.Limits.CheckTotalDice(e.PriorRolledDice + e.env.NumOfRolledDice() + num); err != nil {
+		return nil, err
+	}
+
 	rolledDice, err := e.diceRoller.RollDice(num, sides)
 	if err != nil {
 		return nil, err
@@ -101,6 +130,12 @@ func (e *Evaluator) RollDice(num int, sides int) ([]dice.Die, error) {
 	return rolledDice, nil
 }
 
+// canReroll は、i回目の振り足しを行えるかを返す。
+// 最大振り足し数が0以下の場合は、常に振り足しを行える。
+func (e *Evaluator) canReroll(i int) bool {
+	return e.Limits.MaxRerolls <= 0 || i < e.Limits.MaxRerolls
+}
+
 // objectToIntNode はオブジェクトを整数のノードに変換する。
 //
 // oを*object.Integerに変換できない場合はpanicに陥るので注意。
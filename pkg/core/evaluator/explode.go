@@ -0,0 +1,175 @@
+package evaluator
+
+import (
+	"fmt"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+)
+
+// isExplodeNode は、nodeが振り足しつき加算ロールのノードかどうかを返す。
+func isExplodeNode(node ast.Node) bool {
+	switch node.Type() {
+	case ast.EXPLODE_NODE, ast.COMPOUND_EXPLODE_NODE:
+		return true
+	default:
+		return false
+	}
+}
+
+// evalVarArgsOfExplode は振り足しつき加算ロールのノードの引数を評価して整数に変換する。
+func (e *Evaluator) evalVarArgsOfExplode(node ast.InfixExpression) error {
+	dRollErr := e.evalVarArgsOfRoll(node.Left().(ast.InfixExpression))
+	if dRollErr != nil {
+		return dRollErr
+	}
+
+	// 閾値が省略されている場合はダイスの面数を閾値とするため、評価しない
+	if node.Right().IsNil() {
+		return nil
+	}
+
+	thresholdObj, thresholdErr := e.Eval(node.Right())
+	if thresholdErr != nil {
+		return thresholdErr
+	}
+
+	node.SetRight(objectToIntNode(thresholdObj))
+
+	return nil
+}
+
+// determineValueOfExplode は振り足しつき加算ロールの値を決定する。
+func (e *Evaluator) determineValueOfExplode(
+	node *ast.VariableInfixExpression,
+) (*ast.SumRollResult, error) {
+	dRoll, dRollIsVarInfix := node.Left().(*ast.VariableInfixExpression)
+	if !dRollIsVarInfix || dRoll.Type() != ast.D_ROLL_NODE {
+		return nil, fmt.Errorf("not DRoll: %s", node.Left().Type())
+	}
+
+	num, numIsInt := dRoll.Left().(*ast.Int)
+	if !numIsInt {
+		return nil, fmt.Errorf("num is not Int: %s", dRoll.Left().Type())
+	}
+
+	sides, sidesIsInt := dRoll.Right().(*ast.Int)
+	if !sidesIsInt {
+		return nil, fmt.Errorf("sides is not Int: %s", dRoll.Right().Type())
+	}
+
+	threshold := sides.Value
+	if !node.Right().IsNil() {
+		thresholdNode, thresholdIsInt := node.Right().(*ast.Int)
+		if !thresholdIsInt {
+			return nil, fmt.Errorf("threshold is not Int: %s", node.Right().Type())
+		}
+
+		threshold = thresholdNode.Value
+	}
+
+	return e.rollExplodingDice(
+		num.Value,
+		sides.Value,
+		threshold,
+		node.Type() == ast.COMPOUND_EXPLODE_NODE,
+	)
+}
+
+// evalExplode は振り足しつき加算ロールを評価する。
+func (e *Evaluator) evalExplode(node ast.InfixExpression) (object.Object, error) {
+	dRoll, dRollIsInfix := node.Left().(ast.InfixExpression)
+	if !dRollIsInfix || dRoll.Type() != ast.D_ROLL_NODE {
+		return nil, fmt.Errorf("not DRoll: %s", node.Left().Type())
+	}
+
+	num, sides, operandsErr := e.evalInfixExpressionOperands(dRoll)
+	if operandsErr != nil {
+		return nil, operandsErr
+	}
+
+	sidesVal := sides.(*object.Integer).Value
+
+	threshold := sidesVal
+	if !node.Right().IsNil() {
+		thresholdObj, thresholdErr := e.Eval(node.Right())
+		if thresholdErr != nil {
+			return nil, thresholdErr
+		}
+
+		threshold = thresholdObj.(*object.Integer).Value
+	}
+
+	result, rollErr := e.rollExplodingDice(
+		num.(*object.Integer).Value,
+		sidesVal,
+		threshold,
+		node.Type() == ast.COMPOUND_EXPLODE_NODE,
+	)
+	if rollErr != nil {
+		return nil, rollErr
+	}
+
+	return object.NewInteger(result.Value()), nil
+}
+
+// rollExplodingDice は、閾値以上の出目が出るたびにダイスを振り足しながら、
+// num個のsides面ダイスを振る。
+//
+// 振り足されたダイスは元のダイスの直後に並ぶ。
+// 1個のダイスからの振り足しの回数は、最大振り足し数までに制限される。
+// 閾値が2未満の場合、または面数より大きい場合は *InvalidThresholdError を返す。
+func (e *Evaluator) rollExplodingDice(
+	num int,
+	sides int,
+	threshold int,
+	compounding bool,
+) (*ast.SumRollResult, error) {
+	if threshold < 2 {
+		return nil, &InvalidThresholdError{
+			Specified: true,
+			Value:     threshold,
+			message:   e.Message(locale.THRESHOLD_TOO_SMALL),
+		}
+	}
+
+	// 面数より大きい閾値では振り足しが起こらない。
+	// "2D6!>=10" のように成功判定のつもりで書かれた可能性が高いため、エラーとする
+	if threshold > sides {
+		return nil, &InvalidThresholdError{
+			Specified: true,
+			Value:     threshold,
+			message:   e.Message(locale.THRESHOLD_EXCEEDS_SIDES, sides),
+		}
+	}
+
+	initialDice, rollErr := e.RollDice(num, sides)
+	if rollErr != nil {
+		return nil, rollErr
+	}
+
+	rolledDice := make([]dice.Die, 0, len(initialDice))
+	rerolled := make([]bool, 0, len(initialDice))
+
+	for _, d := range initialDice {
+		rolledDice = append(rolledDice, d)
+		rerolled = append(rerolled, false)
+
+		value := d.Value
+		for j := 0; value >= threshold && e.canReroll(j); j++ {
+			rerolledDice, err := e.RollDice(1, sides)
+			if err != nil {
+				return nil, err
+			}
+
+			rolledDice = append(rolledDice, rerolledDice[0])
+			rerolled = append(rerolled, true)
+
+			value = rerolledDice[0].Value
+		}
+	}
+
+	return ast.NewSumRollResultWithRerolls(rolledDice, rerolled, compounding), nil
+}
@@ -0,0 +1,129 @@
+package evaluator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+)
+
+// isKeepDropNode は、nodeが出目を残す/捨てる加算ロールのノードかどうかを返す。
+func isKeepDropNode(node ast.Node) bool {
+	switch node.Type() {
+	case ast.KEEP_HIGHEST_NODE, ast.KEEP_LOWEST_NODE, ast.DROP_HIGHEST_NODE, ast.DROP_LOWEST_NODE:
+		return true
+	default:
+		return false
+	}
+}
+
+// determineValueOfKeepDrop は出目を残す/捨てる加算ロールの値を決定する。
+//
+// すべてのダイスを振った後、捨てるダイスを加算ロール結果に記録する。
+func (e *Evaluator) determineValueOfKeepDrop(
+	node *ast.VariableInfixExpression,
+) (*ast.SumRollResult, error) {
+	dRoll, dRollIsVarInfix := node.Left().(*ast.VariableInfixExpression)
+	if !dRollIsVarInfix || dRoll.Type() != ast.D_ROLL_NODE {
+		return nil, fmt.Errorf("not DRoll: %s", node.Left().Type())
+	}
+
+	count, countIsInt := node.Right().(*ast.Int)
+	if !countIsInt {
+		return nil, fmt.Errorf("count is not Int: %s", node.Right().Type())
+	}
+
+	result, err := e.determineValueOfDRoll(dRoll)
+	if err != nil {
+		return nil, err
+	}
+
+	dropErr := dropDice(result, node.Type(), count.Value)
+	if dropErr != nil {
+		return nil, dropErr
+	}
+
+	return result, nil
+}
+
+// evalKeepDrop は出目を残す/捨てる加算ロールを評価する。
+func (e *Evaluator) evalKeepDrop(node ast.InfixExpression) (object.Object, error) {
+	dRoll, dRollIsInfix := node.Left().(ast.InfixExpression)
+	if !dRollIsInfix || dRoll.Type() != ast.D_ROLL_NODE {
+		return nil, fmt.Errorf("not DRoll: %s", node.Left().Type())
+	}
+
+	num, sides, operandsErr := e.evalInfixExpressionOperands(dRoll)
+	if operandsErr != nil {
+		return nil, operandsErr
+	}
+
+	count, countErr := e.Eval(node.Right())
+	if countErr != nil {
+		return nil, countErr
+	}
+
+	rolledDice, rollDiceErr := e.RollDice(
+		num.(*object.Integer).Value,
+		sides.(*object.Integer).Value,
+	)
+	if rollDiceErr != nil {
+		return nil, rollDiceErr
+	}
+
+	result := ast.NewSumRollResult(rolledDice)
+
+	dropErr := dropDice(result, node.Type(), count.(*object.Integer).Value)
+	if dropErr != nil {
+		return nil, dropErr
+	}
+
+	return object.NewInteger(result.Value()), nil
+}
+
+// dropDice は、ノードの種類と個数に従って、捨てるダイスを加算ロール結果に記録する。
+//
+// 残す/捨てる個数がダイスの数より多い場合は、ダイスの数に合わせる。
+// 出目が同じダイスの間では、先に振られたものほど小さいものとして扱う。
+func dropDice(result *ast.SumRollResult, nodeType ast.NodeType, count int) error {
+	if count < 0 {
+		return fmt.Errorf("negative count: %d", count)
+	}
+
+	numOfDice := len(result.Dice)
+	if count > numOfDice {
+		count = numOfDice
+	}
+
+	// 出目の昇順に並べたダイスの添字
+	indices := make([]int, numOfDice)
+	for i := range indices {
+		indices[i] = i
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		return result.Dice[indices[i]].Value < result.Dice[indices[j]].Value
+	})
+
+	// 捨てるダイスの添字
+	var indicesToDrop []int
+	switch nodeType {
+	case ast.KEEP_HIGHEST_NODE:
+		indicesToDrop = indices[:numOfDice-count]
+	case ast.KEEP_LOWEST_NODE:
+		indicesToDrop = indices[count:]
+	case ast.DROP_HIGHEST_NODE:
+		indicesToDrop = indices[numOfDice-count:]
+	case ast.DROP_LOWEST_NODE:
+		indicesToDrop = indices[:count]
+	default:
+		return fmt.Errorf("dropDice: unknown node type: %s", nodeType)
+	}
+
+	for _, i := range indicesToDrop {
+		result.Drop(i)
+	}
+
+	return nil
+}
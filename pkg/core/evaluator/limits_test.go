@@ -0,0 +1,143 @@
+package evaluator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+)
+
+func TestEvaluator_RollDice_TotalDiceLimit(t *testing.T) {
+	f := feeder.NewMT19937(1)
+	ev := NewEvaluator(roller.New(f), NewEnvironment())
+	ev.Limits.MaxTotalDice = 5
+
+	if _, err := ev.RollDice(3, 6); err != nil {
+		t.Fatalf("ダイスロールエラー: %s", err)
+	}
+
+	if _, err := ev.RollDice(2, 6); err != nil {
+		t.Fatalf("ダイスロールエラー: %s", err)
+	}
+
+	_, err := ev.RollDice(1, 6)
+
+	var exceeded *limits.ExceededError
+	if !errors.As(err, &exceeded) {
+		t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+	}
+
+	if exceeded.Kind != limits.TOTAL_DICE {
+		t.Errorf("got: %s, want: %s", exceeded.Kind, limits.TOTAL_DICE)
+	}
+
+	if n := len(ev.RolledDice()); n != 5 {
+		t.Errorf("振られたダイスの数が異なる: got %d, want %d", n, 5)
+	}
+}
+
+func TestEvaluator_MaxRerolls_Explode(t *testing.T) {
+	testcases := []struct {
+		maxRerolls int
+		expected   int
+	}{
+		{3, 4},
+		{0, 6},
+	}
+
+	for _, test := range testcases {
+		// 6が出続けた後に1が出る
+		f := feeder.NewQueue([]dice.Die{{6, 6}, {6, 6}, {6, 6}, {6, 6}, {6, 6}, {1, 6}})
+		ev := NewEvaluator(roller.New(f), NewEnvironment())
+		ev.Limits.MaxRerolls = test.maxRerolls
+
+		result, err := ev.rollExplodingDice(1, 6, 6, false)
+		if err != nil {
+			t.Fatalf("評価エラー: %s", err)
+		}
+
+		if n := len(result.Dice); n != test.expected {
+			t.Errorf("MaxRerolls=%d: 振られたダイスの数が異なる: got %d, want %d",
+				test.maxRerolls, n, test.expected)
+		}
+	}
+}
+
+func TestEvaluator_Reroll_SidesLimit(t *testing.T) {
+	ev := NewEvaluator(roller.New(feeder.NewEmptyQueue()), NewEnvironment())
+
+	_, err := ev.rollDiceWithRerolls(1, 2147483647, ">=", 1, false)
+
+	var exceeded *limits.ExceededError
+	if !errors.As(err, &exceeded) {
+		t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+	}
+
+	if exceeded.Kind != limits.SIDES {
+		t.Errorf("Kind: got: %s, want: %s", exceeded.Kind, limits.SIDES)
+	}
+}
+
+func TestEvaluator_MaxRerolls_Reroll(t *testing.T) {
+	testcases := []struct {
+		maxRerolls int
+		expected   int
+	}{
+		{3, 4},
+		{0, 6},
+	}
+
+	for _, test := range testcases {
+		// 1が出続けた後に6が出る
+		f := feeder.NewQueue([]dice.Die{{1, 6}, {1, 6}, {1, 6}, {1, 6}, {1, 6}, {6, 6}})
+		ev := NewEvaluator(roller.New(f), NewEnvironment())
+		ev.Limits.MaxRerolls = test.maxRerolls
+
+		result, err := ev.rollDiceWithRerolls(1, 6, "=", 1, false)
+		if err != nil {
+			t.Fatalf("評価エラー: %s", err)
+		}
+
+		if n := len(result.Dice); n != test.expected {
+			t.Errorf("MaxRerolls=%d: 振られたダイスの数が異なる: got %d, want %d",
+				test.maxRerolls, n, test.expected)
+		}
+
+		if n := len(ev.RolledDice()); n != test.expected {
+			t.Errorf("MaxRerolls=%d: 記録されたダイスの数が異なる: got %d, want %d",
+				test.maxRerolls, n, test.expected)
+		}
+	}
+}
+
+func TestEvaluator_MaxRerolls(t *testing.T) {
+	testcases := []struct {
+		maxRerolls int
+		expected   int
+	}{
+		{3, 3},
+		{0, 6},
+	}
+
+	for _, test := range testcases {
+		// 6が出続けた後に1が出る
+		f := feeder.NewQueue([]dice.Die{{6, 6}, {6, 6}, {6, 6}, {6, 6}, {6, 6}, {1, 6}})
+		ev := NewEvaluator(roller.New(f), NewEnvironment())
+		ev.Limits.MaxRerolls = test.maxRerolls
+
+		if _, err := ev.evalCompoundingRoll(
+			ast.NewURoll(ast.NewInt(1), ast.NewInt(6)), object.NewInteger(6)); err != nil {
+			t.Fatalf("評価エラー: %s", err)
+		}
+
+		if n := len(ev.RolledDice()); n != test.expected {
+			t.Errorf("MaxRerolls=%d: 振られたダイスの数が異なる: got %d, want %d",
+				test.maxRerolls, n, test.expected)
+		}
+	}
+}
@@ -1,8 +1,8 @@
 package evaluator
 
 import (
-	"fmt"
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/core/object"
 )
 
@@ -26,19 +26,51 @@ func (e *Evaluator) SetRerollThreshold(node *ast.Command) error {
 	return nil
 }
 
+// InvalidThresholdError は振り足しの閾値が不正であることを表すエラー。
+type InvalidThresholdError struct {
+	// 閾値が指定されていたかどうか
+	Specified bool
+	// 指定された閾値（評価できなかった場合は0）
+	Value int
+	// 閾値の評価で発生したエラー
+	Err error
+
+	// 設定されている言語のエラーメッセージ
+	message string
+}
+
+// InvalidThresholdError がerrorを実装していることの確認。
+var _ error = (*InvalidThresholdError)(nil)
+
+// Error はエラーメッセージを返す。
+func (e *InvalidThresholdError) Error() string {
+	return e.message
+}
+
+// Unwrap は閾値の評価で発生したエラーを返す。
+func (e *InvalidThresholdError) Unwrap() error {
+	return e.Err
+}
+
 // CheckRRollThreshold は個数振り足しロールの振り足しの閾値をチェックする。
+// 閾値が不正な場合は *InvalidThresholdError を返す。
 func (e *Evaluator) CheckRRollThreshold(node *ast.RRollList) error {
 	if node.Threshold.IsNil() {
-		return fmt.Errorf("2R6>=5 あるいは 2R6[5] のように振り足し目標値を指定してください")
+		return &InvalidThresholdError{
+			message: e.Message(locale.R_ROLL_THRESHOLD_REQUIRED),
+		}
 	}
 
 	return e.checkRollThreshold(node.Threshold)
 }
 
 // CheckURollThreshold は上方無限ロールの振り足しの閾値をチェックする。
+// 閾値が不正な場合は *InvalidThresholdError を返す。
 func (e *Evaluator) CheckURollThreshold(node *ast.RRollList) error {
 	if node.Threshold.IsNil() {
-		return fmt.Errorf("2U6[5] のように振り足し目標値を指定してください")
+		return &InvalidThresholdError{
+			message: e.Message(locale.U_ROLL_THRESHOLD_REQUIRED),
+		}
 	}
 
 	return e.checkRollThreshold(node.Threshold)
@@ -48,14 +80,22 @@ func (e *Evaluator) CheckURollThreshold(node *ast.RRollList) error {
 func (e *Evaluator) checkRollThreshold(thresholdNode ast.Node) error {
 	thresholdObj, evalErr := e.Eval(thresholdNode)
 	if evalErr != nil {
-		return fmt.Errorf("閾値評価エラー: %s", evalErr)
+		return &InvalidThresholdError{
+			Specified: true,
+			Err:       evalErr,
+			message:   e.Message(locale.THRESHOLD_EVAL_ERROR, evalErr),
+		}
 	}
 
 	thresholdInt := thresholdObj.(*object.Integer)
 	threshold := thresholdInt.Value
 
 	if threshold < 2 {
-		return fmt.Errorf("振り足し目標値として2以上の整数を指定してください")
+		return &InvalidThresholdError{
+			Specified: true,
+			Value:     threshold,
+			message:   e.Message(locale.THRESHOLD_TOO_SMALL),
+		}
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package evaluator
 
 import (
+	"errors"
 	"fmt"
 	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
@@ -155,3 +156,44 @@ func TestCheckURollThreshold(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckRRollThreshold_InvalidThresholdError(t *testing.T) {
+	testcases := []struct {
+		input     string
+		specified bool
+		value     int
+	}{
+		{"2r6", false, 0},
+		{"2r6[0]", true, 0},
+		{"2r6[1]", true, 1},
+	}
+
+	for _, test := range testcases {
+		t.Run(fmt.Sprintf("%q", test.input), func(t *testing.T) {
+			r, parseErr := parser.Parse("test", []byte(test.input))
+			if parseErr != nil {
+				t.Fatalf("構文エラー: %s", parseErr)
+				return
+			}
+
+			dieFeeder := feeder.NewEmptyQueue()
+			evaluator := NewEvaluator(roller.New(dieFeeder), NewEnvironment())
+
+			checkErr := evaluator.CheckRRollThreshold(r.(*ast.RRollList))
+
+			var thresholdErr *InvalidThresholdError
+			if !errors.As(checkErr, &thresholdErr) {
+				t.Fatalf("InvalidThresholdErrorが返されない: %v", checkErr)
+				return
+			}
+
+			if thresholdErr.Specified != test.specified {
+				t.Errorf("Specified: got=%t, want=%t", thresholdErr.Specified, test.specified)
+			}
+
+			if thresholdErr.Value != test.value {
+				t.Errorf("Value: got=%d, want=%d", thresholdErr.Value, test.value)
+			}
+		})
+	}
+}
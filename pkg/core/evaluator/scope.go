@@ -0,0 +1,164 @@
+package evaluator
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+)
+
+// Scope は変数とマクロを保持するスコープを表す構造体。
+//
+// 評価環境は1回のコマンドの実行ごとに構築されるが、スコープは複数の実行にわたって共有できる。
+// 変数とマクロは同じ名前空間に属し、同じ名前の変数とマクロは共存しない。
+// 複数のゴルーチンから同時に使用することができる。
+//
+// 定義できる変数とマクロの合計数には上限がある。既定の上限は limits.Default() のものとなる。
+type Scope struct {
+	// 変数とマクロを保護するロック
+	mu sync.RWMutex
+	// 変数名と値との対応
+	variables map[string]int
+	// マクロ名と本体との対応
+	macros map[string]string
+	// 変数とマクロの合計の最大数（0以下の場合は上限なし）
+	maxVariables int
+}
+
+// マクロ参照のパターン
+var macroRefRe = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// NewScope は新しい空のスコープを返す。
+func NewScope() *Scope {
+	return &Scope{
+		variables: map[string]int{},
+		macros:    map[string]string{},
+
+		maxVariables: limits.Default().MaxVariables,
+	}
+}
+
+// SetMaxVariables は、定義できる変数とマクロの合計の最大数をnに設定する。
+// 0以下の場合は上限なしとなる。
+func (s *Scope) SetMaxVariables(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.maxVariables = n
+}
+
+// Variable は変数nameの値を返す。
+// 変数が定義されていない場合、2番目の返り値はfalseとなる。
+func (s *Scope) Variable(name string) (int, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, ok := s.variables[name]
+	return value, ok
+}
+
+// SetVariable は変数nameに値valueを代入する。
+// 同じ名前のマクロは削除される。
+//
+// 新しく定義すると変数とマクロの合計数が上限を超える場合は、
+// 代入せずに *limits.ExceededError を返す。
+func (s *Scope) SetVariable(name string, value int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkNumOfNames(name); err != nil {
+		return err
+	}
+
+	delete(s.macros, name)
+	s.variables[name] = value
+
+	return nil
+}
+
+// Macro はマクロnameの本体を返す。
+// マクロが定義されていない場合、2番目の返り値はfalseとなる。
+func (s *Scope) Macro(name string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	body, ok := s.macros[name]
+	return body, ok
+}
+
+// SetMacro はマクロnameを本体bodyで定義する。
+// 同じ名前の変数は削除される。
+//
+// 新しく定義すると変数とマクロの合計数が上限を超える場合は、
+// 定義せずに *limits.ExceededError を返す。
+func (s *Scope) SetMacro(name string, body string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkNumOfNames(name); err != nil {
+		return err
+	}
+
+	delete(s.variables, name)
+	s.macros[name] = body
+
+	return nil
+}
+
+// checkNumOfNames は、名前nameを定義した後の変数とマクロの合計数が上限以下であるかを確認する。
+// 既に定義されている名前の場合は合計数が変わらないため、常にnilを返す。
+//
+// ロックを獲得した状態で呼び出すこと。
+func (s *Scope) checkNumOfNames(name string) error {
+	_, isVariable := s.variables[name]
+	_, isMacro := s.macros[name]
+	if isVariable || isMacro {
+		return nil
+	}
+
+	l := limits.Limits{MaxVariables: s.maxVariables}
+	return l.CheckVariables(len(s.variables) + len(s.macros) + 1)
+}
+
+// Names は定義されている変数とマクロの名前のスライスを返す。
+// 名前はソートされている。
+func (s *Scope) Names() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, 0, len(s.variables)+len(s.macros))
+	for name := range s.variables {
+		names = append(names, name)
+	}
+
+	for name := range s.macros {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// ExpandMacros は、入力input中のマクロ参照 {name} をマクロの本体に置き換えた文字列を返す。
+//
+// 展開は1回のみ行い、展開結果に含まれるマクロ参照は展開しない。
+// マクロとして定義されていない名前の参照（変数参照など）はそのまま残す。
+func (s *Scope) ExpandMacros(input string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.macros) < 1 {
+		return input
+	}
+
+	return macroRefRe.ReplaceAllStringFunc(input, func(ref string) string {
+		name := ref[1 : len(ref)-1]
+		if body, ok := s.macros[name]; ok {
+			return body
+		}
+
+		return ref
+	})
+}
@@ -0,0 +1,137 @@
+package evaluator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+)
+
+func TestScope_Variable(t *testing.T) {
+	s := NewScope()
+
+	if _, ok := s.Variable("atk"); ok {
+		t.Fatal("定義されていない変数が見つかった")
+	}
+
+	s.SetVariable("atk", 12)
+
+	value, ok := s.Variable("atk")
+	if !ok {
+		t.Fatal("変数が見つからない")
+	}
+
+	if value != 12 {
+		t.Errorf("got: %d, want: %d", value, 12)
+	}
+
+	if _, ok := s.Variable("ATK"); ok {
+		t.Error("変数名の大文字と小文字が区別されていない")
+	}
+}
+
+func TestScope_SharedNamespace(t *testing.T) {
+	s := NewScope()
+
+	s.SetVariable("atk", 12)
+	s.SetMacro("atk", "2D6+5")
+
+	if _, ok := s.Variable("atk"); ok {
+		t.Error("マクロを定義しても同名の変数が残っている")
+	}
+
+	s.SetVariable("atk", 3)
+
+	if _, ok := s.Macro("atk"); ok {
+		t.Error("変数に代入しても同名のマクロが残っている")
+	}
+}
+
+func TestScope_MaxVariables(t *testing.T) {
+	s := NewScope()
+	s.SetMaxVariables(2)
+
+	if err := s.SetVariable("a", 1); err != nil {
+		t.Fatalf("エラーが発生しました: %s", err)
+	}
+
+	if err := s.SetMacro("b", "2D6"); err != nil {
+		t.Fatalf("エラーが発生しました: %s", err)
+	}
+
+	// 既に定義されている名前への再定義は合計数を増やさない
+	if err := s.SetVariable("b", 3); err != nil {
+		t.Fatalf("再定義でエラーが発生しました: %s", err)
+	}
+
+	testcases := []struct {
+		name string
+		set  func() error
+	}{
+		{"Variable", func() error { return s.SetVariable("c", 1) }},
+		{"Macro", func() error { return s.SetMacro("c", "1D6") }},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.set()
+
+			var exceeded *limits.ExceededError
+			if !errors.As(err, &exceeded) {
+				t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+			}
+
+			if exceeded.Kind != limits.VARIABLES {
+				t.Errorf("Kind: got: %s, want: %s", exceeded.Kind, limits.VARIABLES)
+			}
+		})
+	}
+
+	expected := []string{"a", "b"}
+	if actual := s.Names(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got: %v, want: %v", actual, expected)
+	}
+}
+
+func TestScope_Names(t *testing.T) {
+	s := NewScope()
+	s.SetVariable("b", 1)
+	s.SetMacro("c", "2D6")
+	s.SetVariable("a", 2)
+
+	expected := []string{"a", "b", "c"}
+	actual := s.Names()
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("got: %v, want: %v", actual, expected)
+	}
+}
+
+func TestScope_ExpandMacros(t *testing.T) {
+	s := NewScope()
+	s.SetMacro("atk", "2D6+5")
+	s.SetMacro("sanc", "CC<=50")
+	s.SetMacro("self", "{self}+1")
+	s.SetVariable("bonus", 3)
+
+	testcases := []struct {
+		input    string
+		expected string
+	}{
+		{"{atk}", "2D6+5"},
+		{"S{sanc} 正気度", "SCC<=50 正気度"},
+		{"{atk}+{bonus}", "2D6+5+{bonus}"},
+		{"{undefined}+1D6", "{undefined}+1D6"},
+		{"{self}", "{self}+1"},
+		{"2D6", "2D6"},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.input, func(t *testing.T) {
+			actual := s.ExpandMacros(test.input)
+			if actual != test.expected {
+				t.Errorf("got: %q, want: %q", actual, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package evaluator
+
+import (
+	"fmt"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+)
+
+// UndefinedVariableError は、定義されていない変数を参照したことを表すエラー。
+type UndefinedVariableError struct {
+	// 変数名
+	Name string
+}
+
+// UndefinedVariableError がerrorを実装していることの確認。
+var _ error = (*UndefinedVariableError)(nil)
+
+// Error はエラーメッセージを返す。
+func (e *UndefinedVariableError) Error() string {
+	return fmt.Sprintf("undefined variable: %s", e.Name)
+}
+
+// SetVariable は変数nameに値valueを代入する。
+// 変数とマクロの合計数が上限を超える場合は *limits.ExceededError を返す。
+func (e *Evaluator) SetVariable(name string, value int) error {
+	return e.env.SetVariable(name, value)
+}
+
+// SetMacro はマクロnameを本体bodyで定義する。
+// 変数とマクロの合計数が上限を超える場合は *limits.ExceededError を返す。
+func (e *Evaluator) SetMacro(name string, body string) error {
+	return e.env.SetMacro(name, body)
+}
+
+// ResolveVariableRefs は、node内の変数参照を変数の値を表すノードに置き換える。
+//
+// 置き換えた後の抽象構文木では、中置表記に変数の値が現れる。
+// 定義されていない変数を参照していた場合は *UndefinedVariableError を返す。
+func (e *Evaluator) ResolveVariableRefs(node ast.Node) error {
+	switch n := node.(type) {
+	case *ast.Command:
+		return e.resolveVariableRef(n.Expression, func(newNode ast.Node) {
+			n.Expression = newNode
+		})
+	case *ast.Assign:
+		return e.resolveVariableRef(n.Expression, func(newNode ast.Node) {
+			n.Expression = newNode
+		})
+	case *ast.BRollList:
+		for _, b := range n.BRolls {
+			if err := e.ResolveVariableRefs(b); err != nil {
+				return err
+			}
+		}
+
+		if n.BotchThreshold != nil {
+			return e.resolveVariableRef(n.BotchThreshold, func(newNode ast.Node) {
+				n.BotchThreshold = newNode
+			})
+		}
+	case *ast.RRollList:
+		for _, r := range n.RRolls {
+			if err := e.ResolveVariableRefs(r); err != nil {
+				return err
+			}
+		}
+
+		return e.resolveVariableRef(n.Threshold, func(newNode ast.Node) {
+			n.Threshold = newNode
+		})
+	case *ast.URollExpr:
+		if err := e.ResolveVariableRefs(n.URollList); err != nil {
+			return err
+		}
+
+		if n.Bonus != nil {
+			return e.ResolveVariableRefs(n.Bonus)
+		}
+	case ast.PrefixExpression:
+		return e.resolveVariableRef(n.Right(), n.SetRight)
+	case ast.InfixExpression:
+		if err := e.resolveVariableRef(n.Left(), n.SetLeft); err != nil {
+			return err
+		}
+
+		return e.resolveVariableRef(n.Right(), n.SetRight)
+	}
+
+	return nil
+}
+
+// resolveVariableRef は、nodeが変数参照ならば変数の値を表すノードに置き換える。
+// そうでなければ、node内の変数参照を置き換える。
+func (e *Evaluator) resolveVariableRef(node ast.Node, setter nodeSetter) error {
+	ref, ok := node.(*ast.VariableRef)
+	if !ok {
+		return e.ResolveVariableRefs(node)
+	}
+
+	value, err := e.variableValue(ref)
+	if err != nil {
+		return err
+	}
+
+	if value < 0 {
+		// 中置表記で括弧に囲まれるように、単項マイナスとする
+		setter(ast.NewUnaryMinus(ast.NewInt(-value)))
+	} else {
+		setter(ast.NewInt(value))
+	}
+
+	return nil
+}
+
+// evalVariableRef は変数参照を評価する。
+func (e *Evaluator) evalVariableRef(node *ast.VariableRef) (object.Object, error) {
+	value, err := e.variableValue(node)
+	if err != nil {
+		return nil, err
+	}
+
+	return object.NewInteger(value), nil
+}
+
+// variableValue は参照されている変数の値を返す。
+func (e *Evaluator) variableValue(node *ast.VariableRef) (int, error) {
+	value, ok := e.env.Variable(node.Name)
+	if !ok {
+		return 0, &UndefinedVariableError{
+			Name: node.Name,
+		}
+	}
+
+	return value, nil
+}
@@ -0,0 +1,128 @@
+package evaluator
+
+import (
+	"errors"
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/notation"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+	"github.com/raa0121/GoBCDice/pkg/core/parser"
+	"testing"
+)
+
+func TestResolveVariableRefs(t *testing.T) {
+	testcases := []struct {
+		input    string
+		expected string
+	}{
+		{"{atk}+1D6", "12+1D6"},
+		{"2D6+{neg}", "2D6+(-3)"},
+		{"{n}D6", "2D6"},
+		{"2D6+{atk}>={target}", "2D6+12>=7"},
+		{"{n}B6>={target}", "2B6>=7"},
+		{"{n}R6[{n}]", "2R6[2]"},
+		{"{n}U6[6]+{atk}", "2U6[6]+12"},
+		{"[1...{atk}]D6", "[1...12]D6"},
+		{"C(-{atk})", "C(-12)"},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.input, func(t *testing.T) {
+			r, parseErr := parser.Parse("test", []byte(test.input))
+			if parseErr != nil {
+				t.Fatalf("構文解析エラー: %s", parseErr)
+			}
+
+			node := r.(ast.Node)
+
+			env := NewEnvironment()
+			env.SetVariable("atk", 12)
+			env.SetVariable("neg", -3)
+			env.SetVariable("n", 2)
+			env.SetVariable("target", 7)
+
+			evaluator := NewEvaluator(roller.New(feeder.NewEmptyQueue()), env)
+
+			if err := evaluator.ResolveVariableRefs(node); err != nil {
+				t.Fatalf("変数参照の解決エラー: %s", err)
+			}
+
+			actual, notationErr := notation.InfixNotation(node, true)
+			if notationErr != nil {
+				t.Fatalf("中置表記生成エラー: %s", notationErr)
+			}
+
+			if actual != test.expected {
+				t.Errorf("got: %q, want: %q", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestResolveVariableRefs_Undefined(t *testing.T) {
+	r, parseErr := parser.Parse("test", []byte("2D6+{undefined}"))
+	if parseErr != nil {
+		t.Fatalf("構文解析エラー: %s", parseErr)
+	}
+
+	evaluator := NewEvaluator(roller.New(feeder.NewEmptyQueue()), NewEnvironment())
+
+	err := evaluator.ResolveVariableRefs(r.(ast.Node))
+
+	var undefinedErr *UndefinedVariableError
+	if !errors.As(err, &undefinedErr) {
+		t.Fatalf("*UndefinedVariableError ではありません: %v", err)
+	}
+
+	if undefinedErr.Name != "undefined" {
+		t.Errorf("got: %q, want: %q", undefinedErr.Name, "undefined")
+	}
+}
+
+func TestEvalVariableRef(t *testing.T) {
+	r, parseErr := parser.Parse("test", []byte("C({a}*2+1)"))
+	if parseErr != nil {
+		t.Fatalf("構文解析エラー: %s", parseErr)
+	}
+
+	env := NewEnvironment()
+	env.SetVariable("a", 20)
+
+	evaluator := NewEvaluator(roller.New(feeder.NewEmptyQueue()), env)
+
+	evaluated, evalErr := evaluator.Eval(r.(ast.Node))
+	if evalErr != nil {
+		t.Fatalf("評価エラー: %s", evalErr)
+	}
+
+	obj, ok := evaluated.(*object.Integer)
+	if !ok {
+		t.Fatalf("整数ではありません: %s", evaluated.Inspect())
+	}
+
+	if obj.Value != 41 {
+		t.Errorf("got: %d, want: %d", obj.Value, 41)
+	}
+}
+
+func TestEnvironmentWithScope_SharesVariables(t *testing.T) {
+	scope := NewScope()
+
+	env1 := NewEnvironmentWithScope(scope)
+	env1.SetVariable("atk", 12)
+
+	env2 := NewEnvironmentWithScope(scope)
+	value, ok := env2.Variable("atk")
+	if !ok {
+		t.Fatal("スコープを共有する環境で変数が見つからない")
+	}
+
+	if value != 12 {
+		t.Errorf("got: %d, want: %d", value, 12)
+	}
+
+	if _, ok := NewEnvironment().Variable("atk"); ok {
+		t.Error("新しい環境で変数が見つかった")
+	}
+}
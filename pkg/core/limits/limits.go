@@ -0,0 +1,156 @@
+/*
+コマンドの実行に使用する資源の上限を扱うパッケージ。
+
+非常に多くのダイスを振るコマンドや、深く入れ子になった式によって
+メモリや時間を使い果たすことを防ぐために、構文解析、評価、ダイスロールの各段階で上限を確認する。
+上限を超えた場合は *ExceededError が返される。
+*/
+package limits
+
+import (
+	"fmt"
+)
+
+// Limits はコマンドの実行に使用する資源の上限を表す構造体。
+// 0以下の値は、その項目に上限がないことを表す。
+type Limits struct {
+	// 入力文字列の最大の長さ（バイト数）
+	MaxInputLength int
+	// 抽象構文木の最大の深さ
+	MaxDepth int
+	// 1回のダイスロールで振るダイスの最大数
+	MaxDicePerRoll int
+	// 1回のコマンド実行で振るダイスの合計の最大数
+	MaxTotalDice int
+	// ダイスの最大の面数
+	MaxSides int
+	// 振り足しロールにおける最大振り足し数。
+	// 他の項目と異なり、超えた場合はエラーとせず、振り足しを打ち切る。
+	MaxRerolls int
+	// 繰り返しコマンドの最大繰り返し回数
+	MaxRepeats int
+	// 1つのスコープに定義できる変数とマクロの合計の最大数
+	MaxVariables int
+}
+
+// Default は既定の上限を返す。
+func Default() Limits {
+	return Limits{
+		MaxInputLength: 1000,
+		MaxDepth:       100,
+		MaxDicePerRoll: 1000,
+		MaxTotalDice:   10000,
+		MaxSides:       10000,
+		MaxRerolls:     10000,
+		MaxRepeats:     100,
+		MaxVariables:   1000,
+	}
+}
+
+// Kind は上限の種類を表す型。
+type Kind int
+
+const (
+	// 入力文字列の長さ
+	INPUT_LENGTH Kind = iota
+	// 抽象構文木の深さ
+	DEPTH
+	// 1回のダイスロールで振るダイスの数
+	DICE_PER_ROLL
+	// 1回のコマンド実行で振るダイスの合計数
+	TOTAL_DICE
+	// ダイスの面数
+	SIDES
+	// 繰り返しコマンドの繰り返し回数
+	REPEATS
+	// スコープに定義された変数とマクロの合計数
+	VARIABLES
+)
+
+// String は上限の種類の名前を返す。
+func (k Kind) String() string {
+	switch k {
+	case INPUT_LENGTH:
+		return "input length"
+	case DEPTH:
+		return "depth"
+	case DICE_PER_ROLL:
+		return "dice per roll"
+	case TOTAL_DICE:
+		return "total dice"
+	case SIDES:
+		return "sides"
+	case REPEATS:
+		return "repeats"
+	case VARIABLES:
+		return "variables"
+	}
+
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// ExceededError は上限を超えたことを表すエラー。
+type ExceededError struct {
+	// 上限の種類
+	Kind Kind
+	// 実際の値
+	Value int
+	// 上限
+	Max int
+}
+
+// ExceededError がerrorを実装していることの確認。
+var _ error = (*ExceededError)(nil)
+
+// Error はエラーメッセージを返す。
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("limit exceeded: %s %d > %d", e.Kind, e.Value, e.Max)
+}
+
+// CheckInputLength は入力文字列inputの長さが上限以下であるかを確認する。
+func (l Limits) CheckInputLength(input string) error {
+	return check(INPUT_LENGTH, len(input), l.MaxInputLength)
+}
+
+// CheckDepth は抽象構文木の深さdepthが上限以下であるかを確認する。
+func (l Limits) CheckDepth(depth int) error {
+	return check(DEPTH, depth, l.MaxDepth)
+}
+
+// CheckRoll は、sides個の面を持つダイスをnum個振ることができるかを確認する。
+func (l Limits) CheckRoll(num int, sides int) error {
+	if err := check(DICE_PER_ROLL, num, l.MaxDicePerRoll); err != nil {
+		return err
+	}
+
+	return check(SIDES, sides, l.MaxSides)
+}
+
+// CheckTotalDice は1回のコマンド実行で振るダイスの合計数totalが上限以下であるかを確認する。
+func (l Limits) CheckTotalDice(total int) error {
+	return check(TOTAL_DICE, total, l.MaxTotalDice)
+}
+
+// CheckRepeats は繰り返しコマンドの繰り返し回数nが上限以下であるかを確認する。
+func (l Limits) CheckRepeats(n int) error {
+	return check(REPEATS, n, l.MaxRepeats)
+}
+
+// CheckVariables は、スコープに定義された変数とマクロの合計数nが上限以下であるかを確認する。
+func (l Limits) CheckVariables(n int) error {
+	return check(VARIABLES, n, l.MaxVariables)
+}
+
+// check は値valueが上限max以下であるかを確認する。
+// maxが0以下の場合は上限がないものとする。
+func check(kind Kind, value int, max int) error {
+	if max <= 0 || value <= max {
+		return nil
+	}
+
+	return &ExceededError{
+		Kind:  kind,
+		Value: value,
+		Max:   max,
+	}
+}
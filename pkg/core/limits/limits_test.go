@@ -0,0 +1,71 @@
+package limits
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLimits_Check(t *testing.T) {
+	l := Limits{
+		MaxInputLength: 5,
+		MaxDepth:       3,
+		MaxDicePerRoll: 10,
+		MaxTotalDice:   20,
+		MaxSides:       100,
+		MaxRepeats:     3,
+		MaxVariables:   2,
+	}
+
+	testcases := []struct {
+		name  string
+		check func() error
+		// 期待する上限の種類（エラーを期待しない場合は-1）
+		kind Kind
+	}{
+		{"InputLength-OK", func() error { return l.CheckInputLength("12345") }, -1},
+		{"InputLength-NG", func() error { return l.CheckInputLength("123456") }, INPUT_LENGTH},
+		{"Depth-OK", func() error { return l.CheckDepth(3) }, -1},
+		{"Depth-NG", func() error { return l.CheckDepth(4) }, DEPTH},
+		{"Roll-OK", func() error { return l.CheckRoll(10, 100) }, -1},
+		{"Roll-DicePerRoll", func() error { return l.CheckRoll(11, 100) }, DICE_PER_ROLL},
+		{"Roll-Sides", func() error { return l.CheckRoll(10, 101) }, SIDES},
+		{"TotalDice-OK", func() error { return l.CheckTotalDice(20) }, -1},
+		{"TotalDice-NG", func() error { return l.CheckTotalDice(21) }, TOTAL_DICE},
+		{"Repeats-OK", func() error { return l.CheckRepeats(3) }, -1},
+		{"Repeats-NG", func() error { return l.CheckRepeats(4) }, REPEATS},
+		{"Variables-OK", func() error { return l.CheckVariables(2) }, -1},
+		{"Variables-NG", func() error { return l.CheckVariables(3) }, VARIABLES},
+		{"Unlimited", func() error { return Limits{}.CheckRoll(99999999, 99999999) }, -1},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.check()
+			if test.kind < 0 {
+				if err != nil {
+					t.Fatalf("エラーが発生しました: %s", err)
+				}
+
+				return
+			}
+
+			var exceeded *ExceededError
+			if !errors.As(err, &exceeded) {
+				t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+			}
+
+			if exceeded.Kind != test.kind {
+				t.Errorf("got: %s, want: %s", exceeded.Kind, test.kind)
+			}
+		})
+	}
+}
+
+func TestExceededError_Error(t *testing.T) {
+	err := &ExceededError{Kind: DICE_PER_ROLL, Value: 2000, Max: 1000}
+
+	expected := "limit exceeded: dice per roll 2000 > 1000"
+	if actual := err.Error(); actual != expected {
+		t.Errorf("got: %q, want: %q", actual, expected)
+	}
+}
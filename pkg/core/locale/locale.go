@@ -0,0 +1,134 @@
+/*
+コマンドの実行結果のメッセージを多言語化するためのパッケージ。
+
+メッセージはメッセージIDで識別し、言語ごとのメッセージカタログに登録された書式で整形する。
+BCDiceの基本コマンドのメッセージは、このパッケージで日本語・英語・韓国語・中国語のものを登録している。
+ダイスボット固有のメッセージは、各ダイスボットのパッケージで Register を呼び出して登録する。
+
+指定された言語にメッセージが登録されていない場合は英語、英語にも登録されていない場合は日本語のメッセージを使う。
+*/
+package locale
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Locale は言語を表す型。
+type Locale string
+
+const (
+	// 日本語
+	JA Locale = "ja"
+	// 英語
+	EN Locale = "en"
+	// 韓国語
+	KO Locale = "ko"
+	// 中国語（簡体字）
+	ZH Locale = "zh"
+
+	// 既定の言語
+	DEFAULT = JA
+)
+
+// MessageID はメッセージの識別子を表す型。
+type MessageID string
+
+// Messages はメッセージIDと書式との対応を表す型。
+type Messages map[MessageID]string
+
+var (
+	// 言語ごとのメッセージカタログ
+	catalogs = map[Locale]Messages{}
+	// catalogs を保護するロック
+	catalogsMu sync.RWMutex
+)
+
+// Register は、言語lのメッセージカタログにメッセージを登録する。
+// 既に登録されているメッセージIDの書式は上書きされる。
+func Register(l Locale, messages Messages) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+
+	catalog, ok := catalogs[l]
+	if !ok {
+		catalog = Messages{}
+		catalogs[l] = catalog
+	}
+
+	for id, format := range messages {
+		catalog[id] = format
+	}
+}
+
+// Locales はメッセージカタログが登録されている言語のスライスを返す。
+// 言語はソートされている。
+func Locales() []Locale {
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+
+	locales := make([]Locale, 0, len(catalogs))
+	for l := range catalogs {
+		locales = append(locales, l)
+	}
+
+	sort.Slice(locales, func(i, j int) bool {
+		return locales[i] < locales[j]
+	})
+
+	return locales
+}
+
+// Parse は文字列sを言語に変換する。
+// 大文字・小文字は区別しない。また、"en-US" のような地域の指定は無視する。
+func Parse(s string) (Locale, error) {
+	lang := strings.ToLower(s)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+
+	l := Locale(lang)
+
+	catalogsMu.RLock()
+	_, ok := catalogs[l]
+	catalogsMu.RUnlock()
+
+	if !ok {
+		return "", fmt.Errorf("unsupported locale: %q", s)
+	}
+
+	return l, nil
+}
+
+// Sprintf は、メッセージIDがidのメッセージを引数argsで整形して返す。
+//
+// 言語lにメッセージが登録されていない場合は英語、日本語の順に探す。
+// どの言語にも登録されていない場合はメッセージIDをそのまま書式として使う。
+func (l Locale) Sprintf(id MessageID, args ...interface{}) string {
+	format := l.lookup(id)
+	if len(args) < 1 {
+		return format
+	}
+
+	return fmt.Sprintf(format, args...)
+}
+
+// lookup はメッセージIDがidのメッセージの書式を探す。
+func (l Locale) lookup(id MessageID) string {
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+
+	if l == "" {
+		l = DEFAULT
+	}
+
+	for _, candidate := range []Locale{l, EN, JA} {
+		if format, ok := catalogs[candidate][id]; ok {
+			return format
+		}
+	}
+
+	return string(id)
+}
@@ -0,0 +1,106 @@
+package locale
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testcases := []struct {
+		input    string
+		expected Locale
+		err      bool
+	}{
+		{"ja", JA, false},
+		{"en", EN, false},
+		{"ko", KO, false},
+		{"zh", ZH, false},
+		{"EN", EN, false},
+		{"en-US", EN, false},
+		{"zh_CN", ZH, false},
+		{"", "", true},
+		{"fr", "", true},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.input, func(t *testing.T) {
+			actual, err := Parse(test.input)
+			if test.err {
+				if err == nil {
+					t.Fatal("エラーが発生しませんでした")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("エラーが発生しました: %s", err)
+			}
+
+			if actual != test.expected {
+				t.Errorf("got: %q, want: %q", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestLocale_Sprintf(t *testing.T) {
+	const (
+		onlyJA MessageID = "test.only_ja"
+		jaEN   MessageID = "test.ja_en"
+	)
+
+	Register(JA, Messages{
+		onlyJA: "日本語のみ",
+		jaEN:   "日本語%d",
+	})
+	Register(EN, Messages{
+		jaEN: "English %d",
+	})
+
+	testcases := []struct {
+		locale   Locale
+		id       MessageID
+		args     []interface{}
+		expected string
+	}{
+		{JA, SUCCESS, nil, "成功"},
+		{EN, SUCCESS, nil, "Success"},
+		{KO, SUCCESS, nil, "성공"},
+		{ZH, FAILURE, nil, "失败"},
+		{JA, NUM_OF_SUCCESSES, []interface{}{3}, "成功数3"},
+		{EN, NUM_OF_SUCCESSES, []interface{}{3}, "Successes: 3"},
+		{"", SUCCESS, nil, "成功"},
+		{JA, jaEN, []interface{}{1}, "日本語1"},
+		// 英語にフォールバックする
+		{KO, jaEN, []interface{}{1}, "English 1"},
+		// 日本語にフォールバックする
+		{EN, onlyJA, nil, "日本語のみ"},
+		{ZH, onlyJA, nil, "日本語のみ"},
+		// 登録されていないメッセージID
+		{JA, "test.unknown", nil, "test.unknown"},
+	}
+
+	for _, test := range testcases {
+		t.Run(string(test.locale)+"/"+string(test.id), func(t *testing.T) {
+			actual := test.locale.Sprintf(test.id, test.args...)
+			if actual != test.expected {
+				t.Errorf("got: %q, want: %q", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestLocales(t *testing.T) {
+	actual := Locales()
+	expected := []Locale{EN, JA, KO, ZH}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("got: %v, want: %v", actual, expected)
+	}
+
+	for i, l := range expected {
+		if actual[i] != l {
+			t.Fatalf("got: %v, want: %v", actual, expected)
+		}
+	}
+}
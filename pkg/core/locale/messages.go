@@ -0,0 +1,103 @@
+package locale
+
+// BCDiceの基本コマンドのメッセージID
+const (
+	// 成功判定の結果：成功
+	SUCCESS MessageID = "success"
+	// 成功判定の結果：失敗
+	FAILURE MessageID = "failure"
+	// 成功数（引数：成功数）
+	NUM_OF_SUCCESSES MessageID = "num_of_successes"
+	// 成功数と差し引くボッチの数（引数：成功数、ボッチの数）
+	SUCCESSES_AND_BOTCHES MessageID = "successes_and_botches"
+	// 成功がなく、ボッチがある（大失敗）
+	BOTCH MessageID = "botch"
+	// 計算結果の見出し
+	CALC_RESULT MessageID = "calc_result"
+	// 上方無限ロールの最大値と合計値（引数：最大値、合計値）
+	MAX_AND_SUM MessageID = "max_and_sum"
+	// 個数振り足しロールの振り足し目標値が指定されていない
+	R_ROLL_THRESHOLD_REQUIRED MessageID = "r_roll_threshold_required"
+	// 上方無限ロールの振り足し目標値が指定されていない
+	U_ROLL_THRESHOLD_REQUIRED MessageID = "u_roll_threshold_required"
+	// 振り足し目標値の評価エラー（引数：エラー）
+	THRESHOLD_EVAL_ERROR MessageID = "threshold_eval_error"
+	// 振り足し目標値が小さすぎる
+	THRESHOLD_TOO_SMALL MessageID = "threshold_too_small"
+	// 振り直しの条件をすべての出目が満たしている
+	REROLL_CONDITION_ALWAYS_MET MessageID = "reroll_condition_always_met"
+	// ボッチの閾値以下の出目が成功にもなる
+	BOTCH_THRESHOLD_OVERLAPS_SUCCESS MessageID = "botch_threshold_overlaps_success"
+	// 振り足し目標値がダイスの面数より大きい（引数：面数）
+	THRESHOLD_EXCEEDS_SIDES MessageID = "threshold_exceeds_sides"
+)
+
+func init() {
+	Register(JA, Messages{
+		SUCCESS:                          "成功",
+		FAILURE:                          "失敗",
+		NUM_OF_SUCCESSES:                 "成功数%d",
+		SUCCESSES_AND_BOTCHES:            "成功%d, ボッチ%d",
+		BOTCH:                            "大失敗",
+		CALC_RESULT:                      "計算結果",
+		MAX_AND_SUM:                      "%d/%d (最大/合計)",
+		R_ROLL_THRESHOLD_REQUIRED:        "2R6>=5 あるいは 2R6[5] のように振り足し目標値を指定してください",
+		U_ROLL_THRESHOLD_REQUIRED:        "2U6[5] のように振り足し目標値を指定してください",
+		THRESHOLD_EVAL_ERROR:             "閾値評価エラー: %s",
+		THRESHOLD_TOO_SMALL:              "振り足し目標値として2以上の整数を指定してください",
+		REROLL_CONDITION_ALWAYS_MET:      "すべての出目が振り直しの条件を満たしています",
+		BOTCH_THRESHOLD_OVERLAPS_SUCCESS: "成功となる出目がボッチの閾値以下にならないように指定してください",
+		THRESHOLD_EXCEEDS_SIDES:          "振り足し目標値として面数（%d）以下の整数を指定してください。成功判定と組み合わせる場合は 2D6!>=5>=10 のように指定してください",
+	})
+
+	Register(EN, Messages{
+		SUCCESS:                          "Success",
+		FAILURE:                          "Failure",
+		NUM_OF_SUCCESSES:                 "Successes: %d",
+		SUCCESSES_AND_BOTCHES:            "%d successes, %d botches",
+		BOTCH:                            "Botch",
+		CALC_RESULT:                      "Result",
+		MAX_AND_SUM:                      "%d/%d (max/total)",
+		R_ROLL_THRESHOLD_REQUIRED:        "specify the reroll threshold, e.g. 2R6>=5 or 2R6[5]",
+		U_ROLL_THRESHOLD_REQUIRED:        "specify the reroll threshold, e.g. 2U6[5]",
+		THRESHOLD_EVAL_ERROR:             "threshold evaluation error: %s",
+		THRESHOLD_TOO_SMALL:              "the reroll threshold must be an integer of 2 or more",
+		REROLL_CONDITION_ALWAYS_MET:      "every face of the die meets the reroll condition",
+		BOTCH_THRESHOLD_OVERLAPS_SUCCESS: "the botch threshold must not overlap the success range",
+		THRESHOLD_EXCEEDS_SIDES:          "the reroll threshold must not exceed the number of sides (%d); to add a success check, write e.g. 2D6!>=5>=10",
+	})
+
+	Register(KO, Messages{
+		SUCCESS:                          "성공",
+		FAILURE:                          "실패",
+		NUM_OF_SUCCESSES:                 "성공 수 %d",
+		SUCCESSES_AND_BOTCHES:            "성공 %d, 보치 %d",
+		BOTCH:                            "대실패",
+		CALC_RESULT:                      "계산 결과",
+		MAX_AND_SUM:                      "%d/%d (최대/합계)",
+		R_ROLL_THRESHOLD_REQUIRED:        "2R6>=5 또는 2R6[5]처럼 추가 굴림 목표값을 지정하십시오",
+		U_ROLL_THRESHOLD_REQUIRED:        "2U6[5]처럼 추가 굴림 목표값을 지정하십시오",
+		THRESHOLD_EVAL_ERROR:             "임계값 평가 오류: %s",
+		THRESHOLD_TOO_SMALL:              "추가 굴림 목표값으로 2 이상의 정수를 지정하십시오",
+		REROLL_CONDITION_ALWAYS_MET:      "모든 눈이 다시 굴림 조건을 만족합니다",
+		BOTCH_THRESHOLD_OVERLAPS_SUCCESS: "보치 임계값이 성공 범위와 겹치지 않도록 지정하십시오",
+		THRESHOLD_EXCEEDS_SIDES:          "추가 굴림 목표값으로 면 수(%d) 이하의 정수를 지정하십시오. 성공 판정과 함께 쓰려면 2D6!>=5>=10처럼 지정하십시오",
+	})
+
+	Register(ZH, Messages{
+		SUCCESS:                          "成功",
+		FAILURE:                          "失败",
+		NUM_OF_SUCCESSES:                 "成功数%d",
+		SUCCESSES_AND_BOTCHES:            "成功%d, 失误%d",
+		BOTCH:                            "大失败",
+		CALC_RESULT:                      "计算结果",
+		MAX_AND_SUM:                      "%d/%d (最大/合计)",
+		R_ROLL_THRESHOLD_REQUIRED:        "请像 2R6>=5 或 2R6[5] 这样指定追加掷骰的目标值",
+		U_ROLL_THRESHOLD_REQUIRED:        "请像 2U6[5] 这样指定追加掷骰的目标值",
+		THRESHOLD_EVAL_ERROR:             "阈值求值错误: %s",
+		THRESHOLD_TOO_SMALL:              "请指定2以上的整数作为追加掷骰的目标值",
+		REROLL_CONDITION_ALWAYS_MET:      "所有点数都满足重掷条件",
+		BOTCH_THRESHOLD_OVERLAPS_SUCCESS: "失误阈值不能与成功范围重叠",
+		THRESHOLD_EXCEEDS_SIDES:          "请指定不超过面数（%d）的整数作为追加掷骰的目标值。如需同时进行成功判定，请像 2D6!>=5>=10 这样指定",
+	})
+}
@@ -38,10 +38,14 @@ func InfixNotation(node ast.Node, walkingToLeft bool) (string, error) {
 		return infixNotationOfURollExpr(n)
 	case *ast.Choice:
 		return infixNotationOfChoice(n)
+	case *ast.D66:
+		return infixNotationOfD66(n)
 	case *ast.Command:
 		return infixNotationOfCommand(n, walkingToLeft)
 	case *ast.Divide:
 		return infixNotationOfDivide(n, walkingToLeft)
+	case *ast.Reroll:
+		return infixNotationOfReroll(n)
 	case ast.PrefixExpression:
 		return infixNotationOfPrefixExpression(n, walkingToLeft)
 	case ast.InfixExpression:
@@ -50,11 +54,17 @@ func InfixNotation(node ast.Node, walkingToLeft bool) (string, error) {
 			return infixNotationOfCompare(n)
 		case ast.RANDOM_NUMBER_NODE:
 			return infixNotationOfRandomNumber(n)
+		case ast.KEEP_HIGHEST_NODE, ast.KEEP_LOWEST_NODE, ast.DROP_HIGHEST_NODE, ast.DROP_LOWEST_NODE:
+			return infixNotationOfKeepDrop(n)
+		case ast.EXPLODE_NODE, ast.COMPOUND_EXPLODE_NODE:
+			return infixNotationOfExplode(n)
 		default:
 			return infixNotationOfInfixExpression(n, walkingToLeft)
 		}
 	case *ast.Int:
 		return fmt.Sprintf("%d", n.Value), nil
+	case *ast.VariableRef:
+		return "{" + n.Name + "}", nil
 	case *ast.SumRollResult:
 		return infixNotationOfSumRollResult(n)
 	}
@@ -67,6 +77,8 @@ func infixNotationOfCommand(node *ast.Command, walkingToLeft bool) (string, erro
 	switch node.Type() {
 	case ast.CALC_NODE:
 		return infixNotationOfCalc(node)
+	case ast.B_ROLL_COMP_NODE:
+		return infixNotationOfBRollComp(node, walkingToLeft)
 	default:
 		return infixNotationOfNormalCommand(node, walkingToLeft)
 	}
@@ -92,6 +104,33 @@ func infixNotationOfCalc(node *ast.Command) (string, error) {
 	return fmt.Sprintf("C(%s)", expr), nil
 }
 
+// infixNotationOfBRollComp はバラバラロールの成功数カウントの中置表記を返す。
+//
+// ボッチの閾値が指定されている場合は、目標値の後に "[閾値]" を付ける。
+func infixNotationOfBRollComp(node *ast.Command, walkingToLeft bool) (string, error) {
+	expr, err := infixNotationOfNormalCommand(node, walkingToLeft)
+	if err != nil {
+		return "", err
+	}
+
+	compareNode, isInfix := node.Expression.(ast.InfixExpression)
+	if !isInfix {
+		return expr, nil
+	}
+
+	bRollList, isBRollList := compareNode.Left().(*ast.BRollList)
+	if !isBRollList || bRollList.BotchThreshold == nil || bRollList.BotchThreshold.IsNil() {
+		return expr, nil
+	}
+
+	botchThreshold, err := InfixNotation(bRollList.BotchThreshold, true)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s[%s]", expr, botchThreshold), nil
+}
+
 // infixNotationOfBRollList はバラバラロール列の中置表記を返す。
 func infixNotationOfBRollList(node *ast.BRollList) (string, error) {
 	infixNotations := make([]string, 0, len(node.BRolls))
@@ -182,6 +221,11 @@ func infixNotationOfChoice(node *ast.Choice) (string, error) {
 	return out.String(), nil
 }
 
+// infixNotationOfD66 はD66ダイスの中置表記を返す。
+func infixNotationOfD66(node *ast.D66) (string, error) {
+	return "D66" + node.Order.Suffix(), nil
+}
+
 // infixNotationOfCompare は比較式の中置表記を返す。
 func infixNotationOfCompare(node ast.InfixExpression) (string, error) {
 	leftInfixNotation, leftErr := InfixNotation(node.Left(), true)
@@ -360,11 +404,99 @@ func infixNotationOfRandomNumber(node ast.InfixExpression) (string, error) {
 	return out.String(), nil
 }
 
+// infixNotationOfKeepDrop は出目を残す/捨てる加算ロールの中置表記を返す。
+func infixNotationOfKeepDrop(node ast.InfixExpression) (string, error) {
+	dRoll, dRollErr := InfixNotation(node.Left(), true)
+	if dRollErr != nil {
+		return "", dRollErr
+	}
+
+	count, countErr := parenthesizeChildOfInfixExpression(
+		node,
+		node.Right(),
+		node.IsRightAssociative(),
+		false,
+	)
+	if countErr != nil {
+		return "", countErr
+	}
+
+	return dRoll + node.Operator() + count, nil
+}
+
+// infixNotationOfExplode は振り足しつき加算ロールの中置表記を返す。
+//
+// 閾値が省略されている場合は、演算子のみを付ける。
+func infixNotationOfExplode(node ast.InfixExpression) (string, error) {
+	dRoll, dRollErr := InfixNotation(node.Left(), true)
+	if dRollErr != nil {
+		return "", dRollErr
+	}
+
+	if node.Right().IsNil() {
+		return dRoll + node.Operator(), nil
+	}
+
+	threshold, thresholdErr := parenthesizeChildOfInfixExpression(
+		node,
+		node.Right(),
+		node.IsRightAssociative(),
+		false,
+	)
+	if thresholdErr != nil {
+		return "", thresholdErr
+	}
+
+	return dRoll + node.Operator() + ">=" + threshold, nil
+}
+
+// infixNotationOfReroll は振り直しつき加算ロールの中置表記を返す。
+//
+// 比較演算子が "=" の場合は省略する（例："2D6R1"、"4D6RO<3"）。
+func infixNotationOfReroll(node *ast.Reroll) (string, error) {
+	dRoll, dRollErr := InfixNotation(node.Left(), true)
+	if dRollErr != nil {
+		return "", dRollErr
+	}
+
+	threshold, thresholdErr := parenthesizeChildOfInfixExpression(
+		node,
+		node.Right(),
+		node.IsRightAssociative(),
+		false,
+	)
+	if thresholdErr != nil {
+		return "", thresholdErr
+	}
+
+	compareOperator := node.CompareOperator
+	if compareOperator == "=" {
+		compareOperator = ""
+	}
+
+	return dRoll + node.Operator() + compareOperator + threshold, nil
+}
+
 // infixNotationOfSumRollResult は加算ロール結果の中置表記を返す。
+//
+// 捨てられたダイスおよび振り直されたダイスの出目は括弧で囲んで示す。
+// 振り足されたダイスの出目は、通常は "15[6,6,3]" のように並べて示し、
+// 上方無限加算ロールでは "15[6+6+3]" のように元のダイスの出目と "+" でつないで示す。
 func infixNotationOfSumRollResult(node *ast.SumRollResult) (string, error) {
 	dieValueStrs := []string{}
 
-	for _, d := range node.Dice {
+	for i, d := range node.Dice {
+		if node.IsDropped(i) {
+			dieValueStrs = append(dieValueStrs, fmt.Sprintf("(%d)", d.Value))
+			continue
+		}
+
+		if node.Compounding && node.IsRerolled(i) && len(dieValueStrs) > 0 {
+			last := len(dieValueStrs) - 1
+			dieValueStrs[last] = fmt.Sprintf("%s+%d", dieValueStrs[last], d.Value)
+			continue
+		}
+
 		dieValueStrs = append(dieValueStrs, fmt.Sprintf("%d", d.Value))
 	}
 
@@ -438,6 +438,8 @@ func TestInfixNotation(t *testing.T) {
 		{"+2D6+1", "2D6+1"},
 		{"2d6+1-1-2-3-4", "2D6+1-1-2-3-4"},
 		{"2D6+4D10", "2D6+4D10"},
+		{"2D6+{atk}", "2D6+{atk}"},
+		{"{n}D6-({a}+1)", "{n}D6-({a}+1)"},
 		{"(2D6)", "2D6"},
 		{"-(2D6)", "-2D6"},
 		{"+(2D6)", "2D6"},
@@ -519,6 +521,8 @@ func TestInfixNotation(t *testing.T) {
 		{"2b6>4-1", "2B6>4-1"},
 		{"2b6+4b10>4", "2B6+4B10>4"},
 		{"2b6>-(-1*3)", "2B6>-(-1*3)"},
+		{"10b10>=8[1]", "10B10>=8[1]"},
+		{"10b10>=8[1+1]", "10B10>=8[1+1]"},
 
 		// 個数振り足しロール
 		{"3r6>=4", "3R6>=4"},
@@ -555,6 +559,25 @@ func TestInfixNotation(t *testing.T) {
 		{"3u6+5u6[6]>=7", "3U6+5U6[6]>=7"},
 		{"(5+6)u10[10]+5>=8", "(5+6)U10[10]+5>=8"},
 
+		// 出目を残す/捨てる加算ロール
+		{"4d6kh3", "4D6KH3"},
+		{"2d20kl1", "2D20KL1"},
+		{"4d6dh1", "4D6DH1"},
+		{"4d6dl1", "4D6DL1"},
+		{"4d6kh3+2", "4D6KH3+2"},
+		{"(2+2)d6kh(1+2)", "(2+2)D6KH(1+2)"},
+		{"4d6kh3>=10", "4D6KH3>=10"},
+		{"1d6!", "1D6!"},
+		{"2d6!>=5", "2D6!>=5"},
+		{"2d6!!", "2D6!!"},
+		{"2d6!!>=(2+3)", "2D6!!>=(2+3)"},
+		{"2d6!+1", "2D6!+1"},
+		{"2d6!>=5>=10", "2D6!>=5>=10"},
+		{"2d6r1", "2D6R1"},
+		{"4d6ro<3", "4D6RO<3"},
+		{"2d6r<=(1+1)", "2D6R<=(1+1)"},
+		{"2d6r1+3>=7", "2D6R1+3>=7"},
+
 		// ランダム選択
 		{"choice[A,B,C]どれにしよう", "CHOICE[A,B,C]"},
 		{"choice[A,B, ]", "CHOICE[A,B]"},
@@ -568,6 +591,11 @@ func TestInfixNotation(t *testing.T) {
 			expected: "CHOICE[日本語,でも,だいじょうぶ]",
 		},
 		{"choice[1+2, (3*4), 5d6]", "CHOICE[1+2,(3*4),5d6]"},
+
+		// D66ダイス
+		{"d66", "D66"},
+		{"d66n", "D66N"},
+		{"d66s", "D66S"},
 	}
 
 	for _, test := range testcase {
@@ -10,6 +10,9 @@ type BRollCompResult struct {
 	Values *Array
 	// 成功数
 	NumOfSuccesses *Integer
+	// 成功数から差し引く出目（ボッチ）の数。
+	// ボッチの閾値が指定されていない場合はnil。
+	NumOfBotches *Integer
 }
 
 // NewBRollCompResult は、新しいバラバラロールの成功数カウントの結果を表すオブジェクトを返す。
@@ -20,6 +23,51 @@ func NewBRollCompResult(values *Array, numOfSuccesses *Integer) *BRollCompResult
 	}
 }
 
+// NewBRollCompResultWithBotches は、ボッチの数を含む、新しいバラバラロールの
+// 成功数カウントの結果を表すオブジェクトを返す。
+func NewBRollCompResultWithBotches(
+	values *Array,
+	numOfSuccesses *Integer,
+	numOfBotches *Integer,
+) *BRollCompResult {
+	return &BRollCompResult{
+		Values:         values,
+		NumOfSuccesses: numOfSuccesses,
+		NumOfBotches:   numOfBotches,
+	}
+}
+
+// HasBotches は、ボッチの数が記録されているかを返す。
+func (r *BRollCompResult) HasBotches() bool {
+	return r.NumOfBotches != nil
+}
+
+// NetNumOfSuccesses は、成功数からボッチの数を差し引いた値を返す。
+// 差し引いた値は0未満にならない。
+// ボッチの数が記録されていない場合は成功数をそのまま返す。
+func (r *BRollCompResult) NetNumOfSuccesses() int {
+	if !r.HasBotches() {
+		return r.NumOfSuccesses.Value
+	}
+
+	net := r.NumOfSuccesses.Value - r.NumOfBotches.Value
+	if net < 0 {
+		return 0
+	}
+
+	return net
+}
+
+// IsGlitch は、成功がなく、かつボッチが1つ以上ある（大失敗）かを返す。
+//
+// World of Darknessの大失敗（botch）のルールに従う。
+// Shadowrunのグリッチ（出目の半数超が1）の判定には対応していない。
+func (r *BRollCompResult) IsGlitch() bool {
+	return r.HasBotches() &&
+		r.NumOfSuccesses.Value == 0 &&
+		r.NumOfBotches.Value > 0
+}
+
 // Type はオブジェクトの種類を返す。
 func (r *BRollCompResult) Type() ObjectType {
 	return B_ROLL_COMP_RESULT_OBJ
@@ -33,6 +81,12 @@ func (r *BRollCompResult) Inspect() string {
 	out.WriteString(r.Values.Inspect())
 	out.WriteString(", NumOfSuccesses=")
 	out.WriteString(r.NumOfSuccesses.Inspect())
+
+	if r.HasBotches() {
+		out.WriteString(", NumOfBotches=")
+		out.WriteString(r.NumOfBotches.Inspect())
+	}
+
 	out.WriteString(">")
 
 	return out.String()
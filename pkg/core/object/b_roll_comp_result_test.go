@@ -34,6 +34,18 @@ func TestBRollCompResult_Inspect(t *testing.T) {
 			},
 			expected: "<BRollCompResult Values=[3, 4, 9, 7, 1, 5], NumOfSuccesses=3>",
 		},
+		{
+			obj: NewBRollCompResultWithBotches(
+				NewArray(
+					NewInteger(8),
+					NewInteger(1),
+					NewInteger(10),
+				),
+				NewInteger(2),
+				NewInteger(1),
+			),
+			expected: "<BRollCompResult Values=[8, 1, 10], NumOfSuccesses=2, NumOfBotches=1>",
+		},
 	}
 
 	for _, test := range testcases {
@@ -45,3 +57,70 @@ func TestBRollCompResult_Inspect(t *testing.T) {
 		})
 	}
 }
+
+func TestBRollCompResult_NetNumOfSuccesses(t *testing.T) {
+	testcases := []struct {
+		obj         *BRollCompResult
+		expectedNet int
+		glitch      bool
+	}{
+		{
+			obj: NewBRollCompResult(
+				NewArray(NewInteger(3), NewInteger(4)),
+				NewInteger(1),
+			),
+			expectedNet: 1,
+			glitch:      false,
+		},
+		{
+			obj: NewBRollCompResultWithBotches(
+				NewArray(NewInteger(8), NewInteger(1), NewInteger(10)),
+				NewInteger(2),
+				NewInteger(1),
+			),
+			expectedNet: 1,
+			glitch:      false,
+		},
+		{
+			obj: NewBRollCompResultWithBotches(
+				NewArray(NewInteger(1), NewInteger(1), NewInteger(5)),
+				NewInteger(0),
+				NewInteger(2),
+			),
+			expectedNet: 0,
+			glitch:      true,
+		},
+		{
+			obj: NewBRollCompResultWithBotches(
+				NewArray(NewInteger(9), NewInteger(1), NewInteger(1)),
+				NewInteger(1),
+				NewInteger(2),
+			),
+			expectedNet: 0,
+			glitch:      false,
+		},
+		{
+			obj: NewBRollCompResultWithBotches(
+				NewArray(NewInteger(2), NewInteger(5)),
+				NewInteger(0),
+				NewInteger(0),
+			),
+			expectedNet: 0,
+			glitch:      false,
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.obj.Inspect(), func(t *testing.T) {
+			actualNet := test.obj.NetNumOfSuccesses()
+			if actualNet != test.expectedNet {
+				t.Errorf("NetNumOfSuccesses: got=%d, want=%d", actualNet, test.expectedNet)
+			}
+
+			actualGlitch := test.obj.IsGlitch()
+			if actualGlitch != test.glitch {
+				t.Errorf("IsGlitch: got=%t, want=%t", actualGlitch, test.glitch)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package parser
+
+// SyntaxError は構文エラーを表す型。
+type SyntaxError struct {
+	// エラーが発生した位置の行番号（1から始まる）
+	Line int
+	// エラーが発生した位置の列番号（1から始まる）
+	Col int
+	// エラーが発生した位置の、入力の先頭からのバイト数
+	Offset int
+	// 構文解析器が返したエラー
+	Err error
+}
+
+// SyntaxError がerrorを実装していることの確認。
+var _ error = (*SyntaxError)(nil)
+
+// Error はエラーメッセージを返す。
+func (e *SyntaxError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap は構文解析器が返したエラーを返す。
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// newSyntaxError は、構文解析器が返したエラーerrから構文エラーを作る。
+// 位置は最初のエラーのものを使う。
+func newSyntaxError(err error) *SyntaxError {
+	syntaxErr := &SyntaxError{
+		Err: err,
+	}
+
+	list, ok := err.(errList)
+	if !ok || len(list) < 1 {
+		return syntaxErr
+	}
+
+	if pe, ok := list[0].(*parserError); ok {
+		syntaxErr.Line = pe.pos.line
+		syntaxErr.Col = pe.pos.col
+		syntaxErr.Offset = pe.pos.offset
+	}
+
+	return syntaxErr
+}
@@ -0,0 +1,35 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+)
+
+// ParseWithLimits は、上限lを確認しながらbを構文解析し、抽象構文木を返す。
+//
+// 構文解析の前に入力の長さを、構文解析の後に抽象構文木の深さを確認する。
+// 上限を超えていた場合は *limits.ExceededError を返す。
+// 構文エラーの場合は *SyntaxError を返す。
+func ParseWithLimits(filename string, b []byte, l limits.Limits, opts ...Option) (ast.Node, error) {
+	if err := l.CheckInputLength(string(b)); err != nil {
+		return nil, err
+	}
+
+	r, err := Parse(filename, b, opts...)
+	if err != nil {
+		return nil, newSyntaxError(err)
+	}
+
+	node, ok := r.(ast.Node)
+	if !ok {
+		return nil, fmt.Errorf("ParseWithLimits: not a node: %T", r)
+	}
+
+	if err := l.CheckDepth(ast.Depth(node)); err != nil {
+		return nil, err
+	}
+
+	return node, nil
+}
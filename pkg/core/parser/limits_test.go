@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/limits"
+)
+
+func TestParseWithLimits(t *testing.T) {
+	l := limits.Limits{
+		MaxInputLength: 20,
+		MaxDepth:       5,
+	}
+
+	testcases := []struct {
+		input string
+		// 期待する上限の種類（エラーを期待しない場合はnil）
+		kind *limits.Kind
+	}{
+		{"2D6+1", nil},
+		{"C((1+2))", nil},
+		{strings.Repeat("1+", 10) + "1", kindPtr(limits.INPUT_LENGTH)},
+		{"C(((((1)))+(2+(3+(4+5)))))", kindPtr(limits.INPUT_LENGTH)},
+		{"C(1+(2+(3+(4+5))))", kindPtr(limits.DEPTH)},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.input, func(t *testing.T) {
+			node, err := ParseWithLimits("test", []byte(test.input), l)
+			if test.kind == nil {
+				if err != nil {
+					t.Fatalf("構文解析エラー: %s", err)
+				}
+
+				if node == nil {
+					t.Fatal("抽象構文木が返されませんでした")
+				}
+
+				return
+			}
+
+			var exceeded *limits.ExceededError
+			if !errors.As(err, &exceeded) {
+				t.Fatalf("上限超過のエラーが発生しませんでした: %v", err)
+			}
+
+			if exceeded.Kind != *test.kind {
+				t.Errorf("got: %s, want: %s", exceeded.Kind, *test.kind)
+			}
+		})
+	}
+}
+
+func TestParseWithLimits_Unlimited(t *testing.T) {
+	input := "C(" + strings.Repeat("(", 50) + "1" + strings.Repeat(")", 50) + ")"
+
+	if _, err := ParseWithLimits("test", []byte(input), limits.Limits{}); err != nil {
+		t.Fatalf("構文解析エラー: %s", err)
+	}
+}
+
+// kindPtr は上限の種類へのポインタを返す。
+func kindPtr(k limits.Kind) *limits.Kind {
+	return &k
+}
+
+func TestParseWithLimits_SyntaxError(t *testing.T) {
+	testcases := []struct {
+		input  string
+		line   int
+		col    int
+		offset int
+	}{
+		{"@2D6", 1, 1, 0},
+		{"xyz", 1, 4, 3},
+		{"2D6+", 1, 5, 4},
+		{"C(1+)", 1, 5, 4},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.input, func(t *testing.T) {
+			_, err := ParseWithLimits("input", []byte(test.input), limits.Default())
+
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("構文エラーが発生しませんでした: %v", err)
+			}
+
+			if syntaxErr.Line != test.line || syntaxErr.Col != test.col || syntaxErr.Offset != test.offset {
+				t.Errorf("wrong position: got %d:%d (%d), want %d:%d (%d)",
+					syntaxErr.Line, syntaxErr.Col, syntaxErr.Offset,
+					test.line, test.col, test.offset)
+			}
+
+			if syntaxErr.Error() == "" {
+				t.Error("エラーメッセージが空です")
+			}
+		})
+	}
+}
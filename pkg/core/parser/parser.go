@@ -109,8 +109,20 @@ var g = &grammar{
 									},
 									&ruleRefExpr{
 										pos:  position{line: 72, col: 46, offset: 1685},
+										name: "D66",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 72, col: 52, offset: 1691},
 										name: "CommandWithExpression",
 									},
+									&ruleRefExpr{
+										pos:  position{line: 72, col: 76, offset: 1715},
+										name: "MacroDefinition",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 72, col: 94, offset: 1733},
+										name: "Assignment",
+									},
 								},
 							},
 						},
@@ -120,98 +132,206 @@ var g = &grammar{
 		},
 		{
 			name: "CommandWithExpression",
-			pos:  position{line: 76, col: 1, offset: 1728},
+			pos:  position{line: 76, col: 1, offset: 1765},
 			expr: &actionExpr{
-				pos: position{line: 76, col: 26, offset: 1753},
+				pos: position{line: 76, col: 26, offset: 1790},
 				run: (*parser).callonCommandWithExpression1,
 				expr: &seqExpr{
-					pos: position{line: 76, col: 26, offset: 1753},
+					pos: position{line: 76, col: 26, offset: 1790},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 76, col: 26, offset: 1753},
+							pos:   position{line: 76, col: 26, offset: 1790},
 							label: "n",
 							expr: &choiceExpr{
-								pos: position{line: 76, col: 29, offset: 1756},
+								pos: position{line: 76, col: 29, offset: 1793},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 29, offset: 1756},
+										pos:  position{line: 76, col: 29, offset: 1793},
 										name: "BRollComp",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 41, offset: 1768},
+										pos:  position{line: 76, col: 41, offset: 1805},
 										name: "BRollList",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 53, offset: 1780},
+										pos:  position{line: 76, col: 53, offset: 1817},
 										name: "RRollComp",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 65, offset: 1792},
+										pos:  position{line: 76, col: 65, offset: 1829},
 										name: "RRollList",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 77, offset: 1804},
+										pos:  position{line: 76, col: 77, offset: 1841},
 										name: "URollComp",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 89, offset: 1816},
+										pos:  position{line: 76, col: 89, offset: 1853},
 										name: "URollExpr",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 101, offset: 1828},
+										pos:  position{line: 76, col: 101, offset: 1865},
 										name: "DRollCompCommand",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 76, col: 120, offset: 1847},
+										pos:  position{line: 76, col: 120, offset: 1884},
 										name: "DRollExprCommand",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 76, col: 138, offset: 1865},
+							pos:  position{line: 76, col: 138, offset: 1902},
+							name: "EOT",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Assignment",
+			pos:  position{line: 80, col: 1, offset: 1926},
+			expr: &actionExpr{
+				pos: position{line: 80, col: 15, offset: 1940},
+				run: (*parser).callonAssignment1,
+				expr: &seqExpr{
+					pos: position{line: 80, col: 15, offset: 1940},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 80, col: 15, offset: 1940},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 80, col: 20, offset: 1945},
+								name: "Identifier",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 80, col: 31, offset: 1956},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 80, col: 35, offset: 1960},
+							label: "expr",
+							expr: &ruleRefExpr{
+								pos:  position{line: 80, col: 40, offset: 1965},
+								name: "DRollExpr",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 80, col: 50, offset: 1975},
 							name: "EOT",
 						},
 					},
 				},
 			},
 		},
+		{
+			name: "MacroDefinition",
+			pos:  position{line: 84, col: 1, offset: 2043},
+			expr: &actionExpr{
+				pos: position{line: 84, col: 20, offset: 2062},
+				run: (*parser).callonMacroDefinition1,
+				expr: &seqExpr{
+					pos: position{line: 84, col: 20, offset: 2062},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 84, col: 20, offset: 2062},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 84, col: 25, offset: 2067},
+								name: "Identifier",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 84, col: 36, offset: 2078},
+							val:        ":=",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 84, col: 41, offset: 2083},
+							expr: &charClassMatcher{
+								pos:        position{line: 84, col: 41, offset: 2083},
+								val:        "[\\pZ]",
+								classes:    []*unicode.RangeTable{rangeTable("Z")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 84, col: 48, offset: 2090},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 84, col: 53, offset: 2095},
+								name: "MacroBody",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MacroBody",
+			pos:  position{line: 88, col: 1, offset: 2176},
+			expr: &actionExpr{
+				pos: position{line: 88, col: 14, offset: 2189},
+				run: (*parser).callonMacroBody1,
+				expr: &seqExpr{
+					pos: position{line: 88, col: 14, offset: 2189},
+					exprs: []interface{}{
+						&charClassMatcher{
+							pos:        position{line: 88, col: 14, offset: 2189},
+							val:        "[^\\pZ]",
+							classes:    []*unicode.RangeTable{rangeTable("Z")},
+							ignoreCase: false,
+							inverted:   true,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 88, col: 21, offset: 2196},
+							expr: &anyMatcher{
+								line: 88, col: 21, offset: 2196,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Choice",
-			pos:  position{line: 80, col: 1, offset: 1889},
+			pos:  position{line: 92, col: 1, offset: 2251},
 			expr: &actionExpr{
-				pos: position{line: 80, col: 11, offset: 1899},
+				pos: position{line: 92, col: 11, offset: 2261},
 				run: (*parser).callonChoice1,
 				expr: &seqExpr{
-					pos: position{line: 80, col: 11, offset: 1899},
+					pos: position{line: 92, col: 11, offset: 2261},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 80, col: 11, offset: 1899},
+							pos:        position{line: 92, col: 11, offset: 2261},
 							val:        "choice[",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 80, col: 22, offset: 1910},
+							pos:   position{line: 92, col: 22, offset: 2272},
 							label: "items",
 							expr: &ruleRefExpr{
-								pos:  position{line: 80, col: 28, offset: 1916},
+								pos:  position{line: 92, col: 28, offset: 2278},
 								name: "ChoiceItems",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 80, col: 40, offset: 1928},
+							pos: position{line: 92, col: 40, offset: 2290},
 							expr: &seqExpr{
-								pos: position{line: 80, col: 41, offset: 1929},
+								pos: position{line: 92, col: 41, offset: 2291},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 80, col: 41, offset: 1929},
+										pos:        position{line: 92, col: 41, offset: 2291},
 										val:        ",",
 										ignoreCase: false,
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 80, col: 45, offset: 1933},
+										pos: position{line: 92, col: 45, offset: 2295},
 										expr: &charClassMatcher{
-											pos:        position{line: 80, col: 45, offset: 1933},
+											pos:        position{line: 92, col: 45, offset: 2295},
 											val:        "[\\pZ]",
 											classes:    []*unicode.RangeTable{rangeTable("Z")},
 											ignoreCase: false,
@@ -222,7 +342,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 80, col: 54, offset: 1942},
+							pos:        position{line: 92, col: 54, offset: 2304},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -232,36 +352,36 @@ var g = &grammar{
 		},
 		{
 			name: "ChoiceItems",
-			pos:  position{line: 84, col: 1, offset: 1970},
+			pos:  position{line: 96, col: 1, offset: 2332},
 			expr: &actionExpr{
-				pos: position{line: 84, col: 16, offset: 1985},
+				pos: position{line: 96, col: 16, offset: 2347},
 				run: (*parser).callonChoiceItems1,
 				expr: &seqExpr{
-					pos: position{line: 84, col: 16, offset: 1985},
+					pos: position{line: 96, col: 16, offset: 2347},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 84, col: 16, offset: 1985},
+							pos:   position{line: 96, col: 16, offset: 2347},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 84, col: 22, offset: 1991},
+								pos:  position{line: 96, col: 22, offset: 2353},
 								name: "ChoiceItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 84, col: 33, offset: 2002},
+							pos:   position{line: 96, col: 33, offset: 2364},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 84, col: 38, offset: 2007},
+								pos: position{line: 96, col: 38, offset: 2369},
 								expr: &seqExpr{
-									pos: position{line: 84, col: 39, offset: 2008},
+									pos: position{line: 96, col: 39, offset: 2370},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 84, col: 39, offset: 2008},
+											pos:        position{line: 96, col: 39, offset: 2370},
 											val:        ",",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 84, col: 43, offset: 2012},
+											pos:  position{line: 96, col: 43, offset: 2374},
 											name: "ChoiceItem",
 										},
 									},
@@ -274,17 +394,17 @@ var g = &grammar{
 		},
 		{
 			name: "ChoiceItem",
-			pos:  position{line: 98, col: 1, offset: 2240},
+			pos:  position{line: 110, col: 1, offset: 2602},
 			expr: &actionExpr{
-				pos: position{line: 98, col: 15, offset: 2254},
+				pos: position{line: 110, col: 15, offset: 2616},
 				run: (*parser).callonChoiceItem1,
 				expr: &seqExpr{
-					pos: position{line: 98, col: 15, offset: 2254},
+					pos: position{line: 110, col: 15, offset: 2616},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 98, col: 15, offset: 2254},
+							pos: position{line: 110, col: 15, offset: 2616},
 							expr: &charClassMatcher{
-								pos:        position{line: 98, col: 15, offset: 2254},
+								pos:        position{line: 110, col: 15, offset: 2616},
 								val:        "[\\pZ]",
 								classes:    []*unicode.RangeTable{rangeTable("Z")},
 								ignoreCase: false,
@@ -292,10 +412,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 98, col: 22, offset: 2261},
+							pos:   position{line: 110, col: 22, offset: 2623},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 98, col: 24, offset: 2263},
+								pos:  position{line: 110, col: 24, offset: 2625},
 								name: "ChoiceItemChars",
 							},
 						},
@@ -305,14 +425,14 @@ var g = &grammar{
 		},
 		{
 			name: "ChoiceItemChars",
-			pos:  position{line: 102, col: 1, offset: 2299},
+			pos:  position{line: 114, col: 1, offset: 2661},
 			expr: &actionExpr{
-				pos: position{line: 102, col: 20, offset: 2318},
+				pos: position{line: 114, col: 20, offset: 2680},
 				run: (*parser).callonChoiceItemChars1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 102, col: 20, offset: 2318},
+					pos: position{line: 114, col: 20, offset: 2680},
 					expr: &charClassMatcher{
-						pos:        position{line: 102, col: 20, offset: 2318},
+						pos:        position{line: 114, col: 20, offset: 2680},
 						val:        "[^\\],]",
 						chars:      []rune{']', ','},
 						ignoreCase: false,
@@ -321,35 +441,71 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "D66",
+			pos:  position{line: 118, col: 1, offset: 2755},
+			expr: &actionExpr{
+				pos: position{line: 118, col: 8, offset: 2762},
+				run: (*parser).callonD661,
+				expr: &seqExpr{
+					pos: position{line: 118, col: 8, offset: 2762},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 118, col: 8, offset: 2762},
+							val:        "d66",
+							ignoreCase: true,
+						},
+						&labeledExpr{
+							pos:   position{line: 118, col: 15, offset: 2769},
+							label: "order",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 118, col: 21, offset: 2775},
+								expr: &charClassMatcher{
+									pos:        position{line: 118, col: 21, offset: 2775},
+									val:        "[NSns]",
+									chars:      []rune{'N', 'S', 'n', 's'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 118, col: 29, offset: 2783},
+							name: "EOT",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Calc",
-			pos:  position{line: 106, col: 1, offset: 2393},
+			pos:  position{line: 133, col: 1, offset: 3094},
 			expr: &actionExpr{
-				pos: position{line: 106, col: 9, offset: 2401},
+				pos: position{line: 133, col: 9, offset: 3102},
 				run: (*parser).callonCalc1,
 				expr: &seqExpr{
-					pos: position{line: 106, col: 9, offset: 2401},
+					pos: position{line: 133, col: 9, offset: 3102},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 106, col: 9, offset: 2401},
+							pos:        position{line: 133, col: 9, offset: 3102},
 							val:        "c",
 							ignoreCase: true,
 						},
 						&litMatcher{
-							pos:        position{line: 106, col: 14, offset: 2406},
+							pos:        position{line: 133, col: 14, offset: 3107},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 106, col: 18, offset: 2410},
+							pos:   position{line: 133, col: 18, offset: 3111},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 106, col: 23, offset: 2415},
+								pos:  position{line: 133, col: 23, offset: 3116},
 								name: "IntExpr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 106, col: 31, offset: 2423},
+							pos:        position{line: 133, col: 31, offset: 3124},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -359,15 +515,15 @@ var g = &grammar{
 		},
 		{
 			name: "DRollExprCommand",
-			pos:  position{line: 110, col: 1, offset: 2474},
+			pos:  position{line: 137, col: 1, offset: 3175},
 			expr: &actionExpr{
-				pos: position{line: 110, col: 21, offset: 2494},
+				pos: position{line: 137, col: 21, offset: 3195},
 				run: (*parser).callonDRollExprCommand1,
 				expr: &labeledExpr{
-					pos:   position{line: 110, col: 21, offset: 2494},
+					pos:   position{line: 137, col: 21, offset: 3195},
 					label: "expr",
 					expr: &ruleRefExpr{
-						pos:  position{line: 110, col: 26, offset: 2499},
+						pos:  position{line: 137, col: 26, offset: 3200},
 						name: "DRollExpr",
 					},
 				},
@@ -375,15 +531,15 @@ var g = &grammar{
 		},
 		{
 			name: "DRollCompCommand",
-			pos:  position{line: 118, col: 1, offset: 2655},
+			pos:  position{line: 145, col: 1, offset: 3356},
 			expr: &actionExpr{
-				pos: position{line: 118, col: 21, offset: 2675},
+				pos: position{line: 145, col: 21, offset: 3376},
 				run: (*parser).callonDRollCompCommand1,
 				expr: &labeledExpr{
-					pos:   position{line: 118, col: 21, offset: 2675},
+					pos:   position{line: 145, col: 21, offset: 3376},
 					label: "expr",
 					expr: &ruleRefExpr{
-						pos:  position{line: 118, col: 26, offset: 2680},
+						pos:  position{line: 145, col: 26, offset: 3381},
 						name: "DRollComp",
 					},
 				},
@@ -391,36 +547,36 @@ var g = &grammar{
 		},
 		{
 			name: "BRollList",
-			pos:  position{line: 126, col: 1, offset: 2836},
+			pos:  position{line: 153, col: 1, offset: 3537},
 			expr: &actionExpr{
-				pos: position{line: 126, col: 14, offset: 2849},
+				pos: position{line: 153, col: 14, offset: 3550},
 				run: (*parser).callonBRollList1,
 				expr: &seqExpr{
-					pos: position{line: 126, col: 14, offset: 2849},
+					pos: position{line: 153, col: 14, offset: 3550},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 126, col: 14, offset: 2849},
+							pos:   position{line: 153, col: 14, offset: 3550},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 126, col: 20, offset: 2855},
+								pos:  position{line: 153, col: 20, offset: 3556},
 								name: "BRoll",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 126, col: 26, offset: 2861},
+							pos:   position{line: 153, col: 26, offset: 3562},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 126, col: 31, offset: 2866},
+								pos: position{line: 153, col: 31, offset: 3567},
 								expr: &seqExpr{
-									pos: position{line: 126, col: 32, offset: 2867},
+									pos: position{line: 153, col: 32, offset: 3568},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 126, col: 32, offset: 2867},
+											pos:        position{line: 153, col: 32, offset: 3568},
 											val:        "+",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 126, col: 36, offset: 2871},
+											pos:  position{line: 153, col: 36, offset: 3572},
 											name: "BRoll",
 										},
 									},
@@ -433,73 +589,99 @@ var g = &grammar{
 		},
 		{
 			name: "BRollComp",
-			pos:  position{line: 138, col: 1, offset: 3111},
+			pos:  position{line: 165, col: 1, offset: 3812},
 			expr: &actionExpr{
-				pos: position{line: 138, col: 14, offset: 3124},
+				pos: position{line: 165, col: 14, offset: 3825},
 				run: (*parser).callonBRollComp1,
 				expr: &seqExpr{
-					pos: position{line: 138, col: 14, offset: 3124},
+					pos: position{line: 165, col: 14, offset: 3825},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 138, col: 14, offset: 3124},
+							pos:   position{line: 165, col: 14, offset: 3825},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 138, col: 19, offset: 3129},
+								pos:  position{line: 165, col: 19, offset: 3830},
 								name: "BRollList",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 138, col: 29, offset: 3139},
+							pos:   position{line: 165, col: 29, offset: 3840},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 138, col: 32, offset: 3142},
+								pos:  position{line: 165, col: 32, offset: 3843},
 								name: "CompareOp",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 138, col: 42, offset: 3152},
+							pos:   position{line: 165, col: 42, offset: 3853},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 138, col: 48, offset: 3158},
+								pos:  position{line: 165, col: 48, offset: 3859},
 								name: "IntExpr",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 165, col: 56, offset: 3867},
+							label: "botch",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 165, col: 62, offset: 3873},
+								expr: &seqExpr{
+									pos: position{line: 165, col: 63, offset: 3874},
+									exprs: []interface{}{
+										&litMatcher{
+											pos:        position{line: 165, col: 63, offset: 3874},
+											val:        "[",
+											ignoreCase: false,
+										},
+										&ruleRefExpr{
+											pos:  position{line: 165, col: 67, offset: 3878},
+											name: "IntExpr",
+										},
+										&litMatcher{
+											pos:        position{line: 165, col: 75, offset: 3886},
+											val:        "]",
+											ignoreCase: false,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "RRollList",
-			pos:  position{line: 148, col: 1, offset: 3293},
+			pos:  position{line: 179, col: 1, offset: 4139},
 			expr: &actionExpr{
-				pos: position{line: 148, col: 14, offset: 3306},
+				pos: position{line: 179, col: 14, offset: 4152},
 				run: (*parser).callonRRollList1,
 				expr: &seqExpr{
-					pos: position{line: 148, col: 14, offset: 3306},
+					pos: position{line: 179, col: 14, offset: 4152},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 148, col: 14, offset: 3306},
+							pos:   position{line: 179, col: 14, offset: 4152},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 148, col: 20, offset: 3312},
+								pos:  position{line: 179, col: 20, offset: 4158},
 								name: "RRoll",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 148, col: 26, offset: 3318},
+							pos:   position{line: 179, col: 26, offset: 4164},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 148, col: 31, offset: 3323},
+								pos: position{line: 179, col: 31, offset: 4169},
 								expr: &seqExpr{
-									pos: position{line: 148, col: 32, offset: 3324},
+									pos: position{line: 179, col: 32, offset: 4170},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 148, col: 32, offset: 3324},
+											pos:        position{line: 179, col: 32, offset: 4170},
 											val:        "+",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 148, col: 36, offset: 3328},
+											pos:  position{line: 179, col: 36, offset: 4174},
 											name: "RRoll",
 										},
 									},
@@ -507,24 +689,24 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 148, col: 44, offset: 3336},
+							pos:   position{line: 179, col: 44, offset: 4182},
 							label: "th",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 148, col: 47, offset: 3339},
+								pos: position{line: 179, col: 47, offset: 4185},
 								expr: &seqExpr{
-									pos: position{line: 148, col: 48, offset: 3340},
+									pos: position{line: 179, col: 48, offset: 4186},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 148, col: 48, offset: 3340},
+											pos:        position{line: 179, col: 48, offset: 4186},
 											val:        "[",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 148, col: 52, offset: 3344},
+											pos:  position{line: 179, col: 52, offset: 4190},
 											name: "IntExpr",
 										},
 										&litMatcher{
-											pos:        position{line: 148, col: 60, offset: 3352},
+											pos:        position{line: 179, col: 60, offset: 4198},
 											val:        "]",
 											ignoreCase: false,
 										},
@@ -538,34 +720,34 @@ var g = &grammar{
 		},
 		{
 			name: "RRollComp",
-			pos:  position{line: 167, col: 1, offset: 3726},
+			pos:  position{line: 198, col: 1, offset: 4572},
 			expr: &actionExpr{
-				pos: position{line: 167, col: 14, offset: 3739},
+				pos: position{line: 198, col: 14, offset: 4585},
 				run: (*parser).callonRRollComp1,
 				expr: &seqExpr{
-					pos: position{line: 167, col: 14, offset: 3739},
+					pos: position{line: 198, col: 14, offset: 4585},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 167, col: 14, offset: 3739},
+							pos:   position{line: 198, col: 14, offset: 4585},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 167, col: 19, offset: 3744},
+								pos:  position{line: 198, col: 19, offset: 4590},
 								name: "RRollList",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 167, col: 29, offset: 3754},
+							pos:   position{line: 198, col: 29, offset: 4600},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 167, col: 32, offset: 3757},
+								pos:  position{line: 198, col: 32, offset: 4603},
 								name: "CompareOp",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 167, col: 42, offset: 3767},
+							pos:   position{line: 198, col: 42, offset: 4613},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 167, col: 48, offset: 3773},
+								pos:  position{line: 198, col: 48, offset: 4619},
 								name: "IntExpr",
 							},
 						},
@@ -575,34 +757,34 @@ var g = &grammar{
 		},
 		{
 			name: "URollComp",
-			pos:  position{line: 177, col: 1, offset: 3908},
+			pos:  position{line: 208, col: 1, offset: 4754},
 			expr: &actionExpr{
-				pos: position{line: 177, col: 14, offset: 3921},
+				pos: position{line: 208, col: 14, offset: 4767},
 				run: (*parser).callonURollComp1,
 				expr: &seqExpr{
-					pos: position{line: 177, col: 14, offset: 3921},
+					pos: position{line: 208, col: 14, offset: 4767},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 177, col: 14, offset: 3921},
+							pos:   position{line: 208, col: 14, offset: 4767},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 177, col: 19, offset: 3926},
+								pos:  position{line: 208, col: 19, offset: 4772},
 								name: "URollExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 177, col: 29, offset: 3936},
+							pos:   position{line: 208, col: 29, offset: 4782},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 177, col: 32, offset: 3939},
+								pos:  position{line: 208, col: 32, offset: 4785},
 								name: "CompareOp",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 177, col: 42, offset: 3949},
+							pos:   position{line: 208, col: 42, offset: 4795},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 177, col: 48, offset: 3955},
+								pos:  position{line: 208, col: 48, offset: 4801},
 								name: "IntExpr",
 							},
 						},
@@ -612,46 +794,46 @@ var g = &grammar{
 		},
 		{
 			name: "URollExpr",
-			pos:  position{line: 187, col: 1, offset: 4090},
+			pos:  position{line: 218, col: 1, offset: 4936},
 			expr: &actionExpr{
-				pos: position{line: 187, col: 14, offset: 4103},
+				pos: position{line: 218, col: 14, offset: 4949},
 				run: (*parser).callonURollExpr1,
 				expr: &seqExpr{
-					pos: position{line: 187, col: 14, offset: 4103},
+					pos: position{line: 218, col: 14, offset: 4949},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 187, col: 14, offset: 4103},
+							pos:   position{line: 218, col: 14, offset: 4949},
 							label: "uRollList",
 							expr: &ruleRefExpr{
-								pos:  position{line: 187, col: 24, offset: 4113},
+								pos:  position{line: 218, col: 24, offset: 4959},
 								name: "URollList",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 187, col: 34, offset: 4123},
+							pos:   position{line: 218, col: 34, offset: 4969},
 							label: "bonus",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 187, col: 40, offset: 4129},
+								pos: position{line: 218, col: 40, offset: 4975},
 								expr: &seqExpr{
-									pos: position{line: 187, col: 41, offset: 4130},
+									pos: position{line: 218, col: 41, offset: 4976},
 									exprs: []interface{}{
 										&choiceExpr{
-											pos: position{line: 187, col: 42, offset: 4131},
+											pos: position{line: 218, col: 42, offset: 4977},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 187, col: 42, offset: 4131},
+													pos:        position{line: 218, col: 42, offset: 4977},
 													val:        "+",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 187, col: 48, offset: 4137},
+													pos:        position{line: 218, col: 48, offset: 4983},
 													val:        "-",
 													ignoreCase: false,
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 187, col: 53, offset: 4142},
+											pos:  position{line: 218, col: 53, offset: 4988},
 											name: "IntExprAdditive",
 										},
 									},
@@ -664,36 +846,36 @@ var g = &grammar{
 		},
 		{
 			name: "URollList",
-			pos:  position{line: 208, col: 1, offset: 4623},
+			pos:  position{line: 239, col: 1, offset: 5469},
 			expr: &actionExpr{
-				pos: position{line: 208, col: 14, offset: 4636},
+				pos: position{line: 239, col: 14, offset: 5482},
 				run: (*parser).callonURollList1,
 				expr: &seqExpr{
-					pos: position{line: 208, col: 14, offset: 4636},
+					pos: position{line: 239, col: 14, offset: 5482},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 208, col: 14, offset: 4636},
+							pos:   position{line: 239, col: 14, offset: 5482},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 208, col: 20, offset: 4642},
+								pos:  position{line: 239, col: 20, offset: 5488},
 								name: "URoll",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 208, col: 26, offset: 4648},
+							pos:   position{line: 239, col: 26, offset: 5494},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 208, col: 31, offset: 4653},
+								pos: position{line: 239, col: 31, offset: 5499},
 								expr: &seqExpr{
-									pos: position{line: 208, col: 32, offset: 4654},
+									pos: position{line: 239, col: 32, offset: 5500},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 208, col: 32, offset: 4654},
+											pos:        position{line: 239, col: 32, offset: 5500},
 											val:        "+",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 208, col: 36, offset: 4658},
+											pos:  position{line: 239, col: 36, offset: 5504},
 											name: "URoll",
 										},
 									},
@@ -701,24 +883,24 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 208, col: 44, offset: 4666},
+							pos:   position{line: 239, col: 44, offset: 5512},
 							label: "th",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 208, col: 47, offset: 4669},
+								pos: position{line: 239, col: 47, offset: 5515},
 								expr: &seqExpr{
-									pos: position{line: 208, col: 48, offset: 4670},
+									pos: position{line: 239, col: 48, offset: 5516},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 208, col: 48, offset: 4670},
+											pos:        position{line: 239, col: 48, offset: 5516},
 											val:        "[",
 											ignoreCase: false,
 										},
 										&ruleRefExpr{
-											pos:  position{line: 208, col: 52, offset: 4674},
+											pos:  position{line: 239, col: 52, offset: 5520},
 											name: "IntExpr",
 										},
 										&litMatcher{
-											pos:        position{line: 208, col: 60, offset: 4682},
+											pos:        position{line: 239, col: 60, offset: 5528},
 											val:        "]",
 											ignoreCase: false,
 										},
@@ -732,54 +914,54 @@ var g = &grammar{
 		},
 		{
 			name: "IntExpr",
-			pos:  position{line: 227, col: 1, offset: 5056},
+			pos:  position{line: 258, col: 1, offset: 5902},
 			expr: &ruleRefExpr{
-				pos:  position{line: 227, col: 12, offset: 5067},
+				pos:  position{line: 258, col: 12, offset: 5913},
 				name: "IntExprAdditive",
 			},
 		},
 		{
 			name: "IntExprAdditive",
-			pos:  position{line: 229, col: 1, offset: 5084},
+			pos:  position{line: 260, col: 1, offset: 5930},
 			expr: &actionExpr{
-				pos: position{line: 229, col: 20, offset: 5103},
+				pos: position{line: 260, col: 20, offset: 5949},
 				run: (*parser).callonIntExprAdditive1,
 				expr: &seqExpr{
-					pos: position{line: 229, col: 20, offset: 5103},
+					pos: position{line: 260, col: 20, offset: 5949},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 229, col: 20, offset: 5103},
+							pos:   position{line: 260, col: 20, offset: 5949},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 229, col: 26, offset: 5109},
+								pos:  position{line: 260, col: 26, offset: 5955},
 								name: "IntExprMultitive",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 229, col: 43, offset: 5126},
+							pos:   position{line: 260, col: 43, offset: 5972},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 229, col: 48, offset: 5131},
+								pos: position{line: 260, col: 48, offset: 5977},
 								expr: &seqExpr{
-									pos: position{line: 229, col: 49, offset: 5132},
+									pos: position{line: 260, col: 49, offset: 5978},
 									exprs: []interface{}{
 										&choiceExpr{
-											pos: position{line: 229, col: 50, offset: 5133},
+											pos: position{line: 260, col: 50, offset: 5979},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 229, col: 50, offset: 5133},
+													pos:        position{line: 260, col: 50, offset: 5979},
 													val:        "+",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 229, col: 56, offset: 5139},
+													pos:        position{line: 260, col: 56, offset: 5985},
 													val:        "-",
 													ignoreCase: false,
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 229, col: 61, offset: 5144},
+											pos:  position{line: 260, col: 61, offset: 5990},
 											name: "IntExprMultitive",
 										},
 									},
@@ -792,43 +974,43 @@ var g = &grammar{
 		},
 		{
 			name: "IntExprMultitive",
-			pos:  position{line: 233, col: 1, offset: 5213},
+			pos:  position{line: 264, col: 1, offset: 6059},
 			expr: &actionExpr{
-				pos: position{line: 233, col: 21, offset: 5233},
+				pos: position{line: 264, col: 21, offset: 6079},
 				run: (*parser).callonIntExprMultitive1,
 				expr: &seqExpr{
-					pos: position{line: 233, col: 21, offset: 5233},
+					pos: position{line: 264, col: 21, offset: 6079},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 233, col: 21, offset: 5233},
+							pos:   position{line: 264, col: 21, offset: 6079},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 233, col: 27, offset: 5239},
+								pos:  position{line: 264, col: 27, offset: 6085},
 								name: "IntExprPrimary",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 233, col: 42, offset: 5254},
+							pos:   position{line: 264, col: 42, offset: 6100},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 233, col: 47, offset: 5259},
+								pos: position{line: 264, col: 47, offset: 6105},
 								expr: &choiceExpr{
-									pos: position{line: 233, col: 48, offset: 5260},
+									pos: position{line: 264, col: 48, offset: 6106},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 233, col: 48, offset: 5260},
+											pos: position{line: 264, col: 48, offset: 6106},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 233, col: 48, offset: 5260},
+													pos:        position{line: 264, col: 48, offset: 6106},
 													val:        "/",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 233, col: 52, offset: 5264},
+													pos:  position{line: 264, col: 52, offset: 6110},
 													name: "IntExprPrimary",
 												},
 												&charClassMatcher{
-													pos:        position{line: 233, col: 67, offset: 5279},
+													pos:        position{line: 264, col: 67, offset: 6125},
 													val:        "[ur]i",
 													chars:      []rune{'u', 'r'},
 													ignoreCase: true,
@@ -837,25 +1019,25 @@ var g = &grammar{
 											},
 										},
 										&seqExpr{
-											pos: position{line: 233, col: 76, offset: 5288},
+											pos: position{line: 264, col: 76, offset: 6134},
 											exprs: []interface{}{
 												&choiceExpr{
-													pos: position{line: 233, col: 77, offset: 5289},
+													pos: position{line: 264, col: 77, offset: 6135},
 													alternatives: []interface{}{
 														&litMatcher{
-															pos:        position{line: 233, col: 77, offset: 5289},
+															pos:        position{line: 264, col: 77, offset: 6135},
 															val:        "*",
 															ignoreCase: false,
 														},
 														&litMatcher{
-															pos:        position{line: 233, col: 83, offset: 5295},
+															pos:        position{line: 264, col: 83, offset: 6141},
 															val:        "/",
 															ignoreCase: false,
 														},
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 233, col: 88, offset: 5300},
+													pos:  position{line: 264, col: 88, offset: 6146},
 													name: "IntExprPrimary",
 												},
 											},
@@ -870,24 +1052,28 @@ var g = &grammar{
 		},
 		{
 			name: "IntExprPrimary",
-			pos:  position{line: 237, col: 1, offset: 5369},
+			pos:  position{line: 268, col: 1, offset: 6215},
 			expr: &choiceExpr{
-				pos: position{line: 237, col: 19, offset: 5387},
+				pos: position{line: 268, col: 19, offset: 6233},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 237, col: 19, offset: 5387},
+						pos:  position{line: 268, col: 19, offset: 6233},
 						name: "Integer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 237, col: 29, offset: 5397},
+						pos:  position{line: 268, col: 29, offset: 6243},
+						name: "VariableRef",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 268, col: 43, offset: 6257},
 						name: "IntExprUnaryPlus",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 237, col: 48, offset: 5416},
+						pos:  position{line: 268, col: 62, offset: 6276},
 						name: "IntExprUnaryMinus",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 237, col: 68, offset: 5436},
+						pos:  position{line: 268, col: 82, offset: 6296},
 						name: "ParenthesizedIntExpr",
 					},
 				},
@@ -895,28 +1081,28 @@ var g = &grammar{
 		},
 		{
 			name: "ParenthesizedIntExpr",
-			pos:  position{line: 239, col: 1, offset: 5458},
+			pos:  position{line: 270, col: 1, offset: 6318},
 			expr: &actionExpr{
-				pos: position{line: 239, col: 25, offset: 5482},
+				pos: position{line: 270, col: 25, offset: 6342},
 				run: (*parser).callonParenthesizedIntExpr1,
 				expr: &seqExpr{
-					pos: position{line: 239, col: 25, offset: 5482},
+					pos: position{line: 270, col: 25, offset: 6342},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 239, col: 25, offset: 5482},
+							pos:        position{line: 270, col: 25, offset: 6342},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 239, col: 29, offset: 5486},
+							pos:   position{line: 270, col: 29, offset: 6346},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 239, col: 31, offset: 5488},
+								pos:  position{line: 270, col: 31, offset: 6348},
 								name: "IntExpr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 239, col: 39, offset: 5496},
+							pos:        position{line: 270, col: 39, offset: 6356},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -926,23 +1112,23 @@ var g = &grammar{
 		},
 		{
 			name: "IntExprUnaryPlus",
-			pos:  position{line: 243, col: 1, offset: 5531},
+			pos:  position{line: 274, col: 1, offset: 6391},
 			expr: &actionExpr{
-				pos: position{line: 243, col: 21, offset: 5551},
+				pos: position{line: 274, col: 21, offset: 6411},
 				run: (*parser).callonIntExprUnaryPlus1,
 				expr: &seqExpr{
-					pos: position{line: 243, col: 21, offset: 5551},
+					pos: position{line: 274, col: 21, offset: 6411},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 243, col: 21, offset: 5551},
+							pos:        position{line: 274, col: 21, offset: 6411},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 243, col: 25, offset: 5555},
+							pos:   position{line: 274, col: 25, offset: 6415},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 243, col: 27, offset: 5557},
+								pos:  position{line: 274, col: 27, offset: 6417},
 								name: "IntExprPrimary",
 							},
 						},
@@ -952,23 +1138,23 @@ var g = &grammar{
 		},
 		{
 			name: "IntExprUnaryMinus",
-			pos:  position{line: 247, col: 1, offset: 5603},
+			pos:  position{line: 278, col: 1, offset: 6463},
 			expr: &actionExpr{
-				pos: position{line: 247, col: 22, offset: 5624},
+				pos: position{line: 278, col: 22, offset: 6484},
 				run: (*parser).callonIntExprUnaryMinus1,
 				expr: &seqExpr{
-					pos: position{line: 247, col: 22, offset: 5624},
+					pos: position{line: 278, col: 22, offset: 6484},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 247, col: 22, offset: 5624},
+							pos:        position{line: 278, col: 22, offset: 6484},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 247, col: 26, offset: 5628},
+							pos:   position{line: 278, col: 26, offset: 6488},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 247, col: 28, offset: 5630},
+								pos:  position{line: 278, col: 28, offset: 6490},
 								name: "IntExprPrimary",
 							},
 						},
@@ -978,34 +1164,34 @@ var g = &grammar{
 		},
 		{
 			name: "DRollComp",
-			pos:  position{line: 251, col: 1, offset: 5695},
+			pos:  position{line: 282, col: 1, offset: 6555},
 			expr: &actionExpr{
-				pos: position{line: 251, col: 14, offset: 5708},
+				pos: position{line: 282, col: 14, offset: 6568},
 				run: (*parser).callonDRollComp1,
 				expr: &seqExpr{
-					pos: position{line: 251, col: 14, offset: 5708},
+					pos: position{line: 282, col: 14, offset: 6568},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 251, col: 14, offset: 5708},
+							pos:   position{line: 282, col: 14, offset: 6568},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 251, col: 19, offset: 5713},
+								pos:  position{line: 282, col: 19, offset: 6573},
 								name: "DRollExprAdditive",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 251, col: 37, offset: 5731},
+							pos:   position{line: 282, col: 37, offset: 6591},
 							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 251, col: 40, offset: 5734},
+								pos:  position{line: 282, col: 40, offset: 6594},
 								name: "CompareOp",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 251, col: 50, offset: 5744},
+							pos:   position{line: 282, col: 50, offset: 6604},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 251, col: 56, offset: 5750},
+								pos:  position{line: 282, col: 56, offset: 6610},
 								name: "IntExpr",
 							},
 						},
@@ -1015,54 +1201,54 @@ var g = &grammar{
 		},
 		{
 			name: "DRollExpr",
-			pos:  position{line: 259, col: 1, offset: 5857},
+			pos:  position{line: 290, col: 1, offset: 6717},
 			expr: &ruleRefExpr{
-				pos:  position{line: 259, col: 14, offset: 5870},
+				pos:  position{line: 290, col: 14, offset: 6730},
 				name: "DRollExprAdditive",
 			},
 		},
 		{
 			name: "DRollExprAdditive",
-			pos:  position{line: 261, col: 1, offset: 5889},
+			pos:  position{line: 292, col: 1, offset: 6749},
 			expr: &actionExpr{
-				pos: position{line: 261, col: 22, offset: 5910},
+				pos: position{line: 292, col: 22, offset: 6770},
 				run: (*parser).callonDRollExprAdditive1,
 				expr: &seqExpr{
-					pos: position{line: 261, col: 22, offset: 5910},
+					pos: position{line: 292, col: 22, offset: 6770},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 261, col: 22, offset: 5910},
+							pos:   position{line: 292, col: 22, offset: 6770},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 261, col: 28, offset: 5916},
+								pos:  position{line: 292, col: 28, offset: 6776},
 								name: "DRollExprMultitive",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 261, col: 47, offset: 5935},
+							pos:   position{line: 292, col: 47, offset: 6795},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 261, col: 52, offset: 5940},
+								pos: position{line: 292, col: 52, offset: 6800},
 								expr: &seqExpr{
-									pos: position{line: 261, col: 53, offset: 5941},
+									pos: position{line: 292, col: 53, offset: 6801},
 									exprs: []interface{}{
 										&choiceExpr{
-											pos: position{line: 261, col: 54, offset: 5942},
+											pos: position{line: 292, col: 54, offset: 6802},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 261, col: 54, offset: 5942},
+													pos:        position{line: 292, col: 54, offset: 6802},
 													val:        "+",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 261, col: 60, offset: 5948},
+													pos:        position{line: 292, col: 60, offset: 6808},
 													val:        "-",
 													ignoreCase: false,
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 261, col: 65, offset: 5953},
+											pos:  position{line: 292, col: 65, offset: 6813},
 											name: "DRollExprMultitive",
 										},
 									},
@@ -1075,43 +1261,43 @@ var g = &grammar{
 		},
 		{
 			name: "DRollExprMultitive",
-			pos:  position{line: 265, col: 1, offset: 6024},
+			pos:  position{line: 296, col: 1, offset: 6884},
 			expr: &actionExpr{
-				pos: position{line: 265, col: 23, offset: 6046},
+				pos: position{line: 296, col: 23, offset: 6906},
 				run: (*parser).callonDRollExprMultitive1,
 				expr: &seqExpr{
-					pos: position{line: 265, col: 23, offset: 6046},
+					pos: position{line: 296, col: 23, offset: 6906},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 265, col: 23, offset: 6046},
+							pos:   position{line: 296, col: 23, offset: 6906},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 265, col: 29, offset: 6052},
+								pos:  position{line: 296, col: 29, offset: 6912},
 								name: "DRollExprPrimary",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 265, col: 46, offset: 6069},
+							pos:   position{line: 296, col: 46, offset: 6929},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 265, col: 51, offset: 6074},
+								pos: position{line: 296, col: 51, offset: 6934},
 								expr: &choiceExpr{
-									pos: position{line: 265, col: 52, offset: 6075},
+									pos: position{line: 296, col: 52, offset: 6935},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 265, col: 52, offset: 6075},
+											pos: position{line: 296, col: 52, offset: 6935},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 265, col: 52, offset: 6075},
+													pos:        position{line: 296, col: 52, offset: 6935},
 													val:        "/",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 265, col: 56, offset: 6079},
+													pos:  position{line: 296, col: 56, offset: 6939},
 													name: "DRollExprPrimary",
 												},
 												&charClassMatcher{
-													pos:        position{line: 265, col: 73, offset: 6096},
+													pos:        position{line: 296, col: 73, offset: 6956},
 													val:        "[ur]i",
 													chars:      []rune{'u', 'r'},
 													ignoreCase: true,
@@ -1120,25 +1306,25 @@ var g = &grammar{
 											},
 										},
 										&seqExpr{
-											pos: position{line: 265, col: 82, offset: 6105},
+											pos: position{line: 296, col: 82, offset: 6965},
 											exprs: []interface{}{
 												&choiceExpr{
-													pos: position{line: 265, col: 83, offset: 6106},
+													pos: position{line: 296, col: 83, offset: 6966},
 													alternatives: []interface{}{
 														&litMatcher{
-															pos:        position{line: 265, col: 83, offset: 6106},
+															pos:        position{line: 296, col: 83, offset: 6966},
 															val:        "*",
 															ignoreCase: false,
 														},
 														&litMatcher{
-															pos:        position{line: 265, col: 89, offset: 6112},
+															pos:        position{line: 296, col: 89, offset: 6972},
 															val:        "/",
 															ignoreCase: false,
 														},
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 265, col: 94, offset: 6117},
+													pos:  position{line: 296, col: 94, offset: 6977},
 													name: "DRollExprPrimary",
 												},
 											},
@@ -1153,32 +1339,48 @@ var g = &grammar{
 		},
 		{
 			name: "DRollExprPrimary",
-			pos:  position{line: 269, col: 1, offset: 6188},
+			pos:  position{line: 300, col: 1, offset: 7048},
 			expr: &choiceExpr{
-				pos: position{line: 269, col: 21, offset: 6208},
+				pos: position{line: 300, col: 21, offset: 7068},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 269, col: 21, offset: 6208},
+						pos:  position{line: 300, col: 21, offset: 7068},
+						name: "KeepDropDRoll",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 300, col: 37, offset: 7084},
+						name: "ExplodeDRoll",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 300, col: 52, offset: 7099},
+						name: "RerollDRoll",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 300, col: 66, offset: 7113},
 						name: "DRoll",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 269, col: 29, offset: 6216},
+						pos:  position{line: 300, col: 74, offset: 7121},
 						name: "RandomNumber",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 269, col: 44, offset: 6231},
+						pos:  position{line: 300, col: 89, offset: 7136},
 						name: "Integer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 269, col: 54, offset: 6241},
+						pos:  position{line: 300, col: 99, offset: 7146},
+						name: "VariableRef",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 300, col: 113, offset: 7160},
 						name: "DRollExprUnaryPlus",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 269, col: 75, offset: 6262},
+						pos:  position{line: 300, col: 134, offset: 7181},
 						name: "DRollExprUnaryMinus",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 269, col: 97, offset: 6284},
+						pos:  position{line: 300, col: 156, offset: 7203},
 						name: "ParenthesizedDRollExpr",
 					},
 				},
@@ -1186,28 +1388,28 @@ var g = &grammar{
 		},
 		{
 			name: "ParenthesizedDRollExpr",
-			pos:  position{line: 271, col: 1, offset: 6308},
+			pos:  position{line: 302, col: 1, offset: 7227},
 			expr: &actionExpr{
-				pos: position{line: 271, col: 27, offset: 6334},
+				pos: position{line: 302, col: 27, offset: 7253},
 				run: (*parser).callonParenthesizedDRollExpr1,
 				expr: &seqExpr{
-					pos: position{line: 271, col: 27, offset: 6334},
+					pos: position{line: 302, col: 27, offset: 7253},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 271, col: 27, offset: 6334},
+							pos:        position{line: 302, col: 27, offset: 7253},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 271, col: 31, offset: 6338},
+							pos:   position{line: 302, col: 31, offset: 7257},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 271, col: 33, offset: 6340},
+								pos:  position{line: 302, col: 33, offset: 7259},
 								name: "DRollExpr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 271, col: 43, offset: 6350},
+							pos:        position{line: 302, col: 43, offset: 7269},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -1217,23 +1419,23 @@ var g = &grammar{
 		},
 		{
 			name: "DRollExprUnaryPlus",
-			pos:  position{line: 275, col: 1, offset: 6385},
+			pos:  position{line: 306, col: 1, offset: 7304},
 			expr: &actionExpr{
-				pos: position{line: 275, col: 23, offset: 6407},
+				pos: position{line: 306, col: 23, offset: 7326},
 				run: (*parser).callonDRollExprUnaryPlus1,
 				expr: &seqExpr{
-					pos: position{line: 275, col: 23, offset: 6407},
+					pos: position{line: 306, col: 23, offset: 7326},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 275, col: 23, offset: 6407},
+							pos:        position{line: 306, col: 23, offset: 7326},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 275, col: 27, offset: 6411},
+							pos:   position{line: 306, col: 27, offset: 7330},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 275, col: 29, offset: 6413},
+								pos:  position{line: 306, col: 29, offset: 7332},
 								name: "DRollExprPrimary",
 							},
 						},
@@ -1243,23 +1445,23 @@ var g = &grammar{
 		},
 		{
 			name: "DRollExprUnaryMinus",
-			pos:  position{line: 279, col: 1, offset: 6461},
+			pos:  position{line: 310, col: 1, offset: 7380},
 			expr: &actionExpr{
-				pos: position{line: 279, col: 24, offset: 6484},
+				pos: position{line: 310, col: 24, offset: 7403},
 				run: (*parser).callonDRollExprUnaryMinus1,
 				expr: &seqExpr{
-					pos: position{line: 279, col: 24, offset: 6484},
+					pos: position{line: 310, col: 24, offset: 7403},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 279, col: 24, offset: 6484},
+							pos:        position{line: 310, col: 24, offset: 7403},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 279, col: 28, offset: 6488},
+							pos:   position{line: 310, col: 28, offset: 7407},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 279, col: 30, offset: 6490},
+								pos:  position{line: 310, col: 30, offset: 7409},
 								name: "DRollExprPrimary",
 							},
 						},
@@ -1269,54 +1471,54 @@ var g = &grammar{
 		},
 		{
 			name: "IntRandExpr",
-			pos:  position{line: 283, col: 1, offset: 6557},
+			pos:  position{line: 314, col: 1, offset: 7476},
 			expr: &ruleRefExpr{
-				pos:  position{line: 283, col: 16, offset: 6572},
+				pos:  position{line: 314, col: 16, offset: 7491},
 				name: "IntRandExprAdditive",
 			},
 		},
 		{
 			name: "IntRandExprAdditive",
-			pos:  position{line: 285, col: 1, offset: 6593},
+			pos:  position{line: 316, col: 1, offset: 7512},
 			expr: &actionExpr{
-				pos: position{line: 285, col: 24, offset: 6616},
+				pos: position{line: 316, col: 24, offset: 7535},
 				run: (*parser).callonIntRandExprAdditive1,
 				expr: &seqExpr{
-					pos: position{line: 285, col: 24, offset: 6616},
+					pos: position{line: 316, col: 24, offset: 7535},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 285, col: 24, offset: 6616},
+							pos:   position{line: 316, col: 24, offset: 7535},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 30, offset: 6622},
+								pos:  position{line: 316, col: 30, offset: 7541},
 								name: "IntRandExprMultitive",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 285, col: 51, offset: 6643},
+							pos:   position{line: 316, col: 51, offset: 7562},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 285, col: 56, offset: 6648},
+								pos: position{line: 316, col: 56, offset: 7567},
 								expr: &seqExpr{
-									pos: position{line: 285, col: 57, offset: 6649},
+									pos: position{line: 316, col: 57, offset: 7568},
 									exprs: []interface{}{
 										&choiceExpr{
-											pos: position{line: 285, col: 58, offset: 6650},
+											pos: position{line: 316, col: 58, offset: 7569},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 285, col: 58, offset: 6650},
+													pos:        position{line: 316, col: 58, offset: 7569},
 													val:        "+",
 													ignoreCase: false,
 												},
 												&litMatcher{
-													pos:        position{line: 285, col: 64, offset: 6656},
+													pos:        position{line: 316, col: 64, offset: 7575},
 													val:        "-",
 													ignoreCase: false,
 												},
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 285, col: 69, offset: 6661},
+											pos:  position{line: 316, col: 69, offset: 7580},
 											name: "IntRandExprMultitive",
 										},
 									},
@@ -1329,43 +1531,43 @@ var g = &grammar{
 		},
 		{
 			name: "IntRandExprMultitive",
-			pos:  position{line: 289, col: 1, offset: 6734},
+			pos:  position{line: 320, col: 1, offset: 7653},
 			expr: &actionExpr{
-				pos: position{line: 289, col: 25, offset: 6758},
+				pos: position{line: 320, col: 25, offset: 7677},
 				run: (*parser).callonIntRandExprMultitive1,
 				expr: &seqExpr{
-					pos: position{line: 289, col: 25, offset: 6758},
+					pos: position{line: 320, col: 25, offset: 7677},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 289, col: 25, offset: 6758},
+							pos:   position{line: 320, col: 25, offset: 7677},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 289, col: 31, offset: 6764},
+								pos:  position{line: 320, col: 31, offset: 7683},
 								name: "IntRandExprPrimary",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 289, col: 50, offset: 6783},
+							pos:   position{line: 320, col: 50, offset: 7702},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 289, col: 55, offset: 6788},
+								pos: position{line: 320, col: 55, offset: 7707},
 								expr: &choiceExpr{
-									pos: position{line: 289, col: 56, offset: 6789},
+									pos: position{line: 320, col: 56, offset: 7708},
 									alternatives: []interface{}{
 										&seqExpr{
-											pos: position{line: 289, col: 56, offset: 6789},
+											pos: position{line: 320, col: 56, offset: 7708},
 											exprs: []interface{}{
 												&litMatcher{
-													pos:        position{line: 289, col: 56, offset: 6789},
+													pos:        position{line: 320, col: 56, offset: 7708},
 													val:        "/",
 													ignoreCase: false,
 												},
 												&ruleRefExpr{
-													pos:  position{line: 289, col: 60, offset: 6793},
+													pos:  position{line: 320, col: 60, offset: 7712},
 													name: "IntRandExprPrimary",
 												},
 												&charClassMatcher{
-													pos:        position{line: 289, col: 79, offset: 6812},
+													pos:        position{line: 320, col: 79, offset: 7731},
 													val:        "[ur]i",
 													chars:      []rune{'u', 'r'},
 													ignoreCase: true,
@@ -1374,25 +1576,25 @@ var g = &grammar{
 											},
 										},
 										&seqExpr{
-											pos: position{line: 289, col: 88, offset: 6821},
+											pos: position{line: 320, col: 88, offset: 7740},
 											exprs: []interface{}{
 												&choiceExpr{
-													pos: position{line: 289, col: 89, offset: 6822},
+													pos: position{line: 320, col: 89, offset: 7741},
 													alternatives: []interface{}{
 														&litMatcher{
-															pos:        position{line: 289, col: 89, offset: 6822},
+															pos:        position{line: 320, col: 89, offset: 7741},
 															val:        "*",
 															ignoreCase: false,
 														},
 														&litMatcher{
-															pos:        position{line: 289, col: 95, offset: 6828},
+															pos:        position{line: 320, col: 95, offset: 7747},
 															val:        "/",
 															ignoreCase: false,
 														},
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 289, col: 100, offset: 6833},
+													pos:  position{line: 320, col: 100, offset: 7752},
 													name: "IntRandExprPrimary",
 												},
 											},
@@ -1407,28 +1609,32 @@ var g = &grammar{
 		},
 		{
 			name: "IntRandExprPrimary",
-			pos:  position{line: 293, col: 1, offset: 6906},
+			pos:  position{line: 324, col: 1, offset: 7825},
 			expr: &choiceExpr{
-				pos: position{line: 293, col: 23, offset: 6928},
+				pos: position{line: 324, col: 23, offset: 7847},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 293, col: 23, offset: 6928},
+						pos:  position{line: 324, col: 23, offset: 7847},
 						name: "Integer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 293, col: 33, offset: 6938},
+						pos:  position{line: 324, col: 33, offset: 7857},
+						name: "VariableRef",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 324, col: 47, offset: 7871},
 						name: "RandomNumber",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 293, col: 48, offset: 6953},
+						pos:  position{line: 324, col: 62, offset: 7886},
 						name: "IntRandExprUnaryPlus",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 293, col: 71, offset: 6976},
+						pos:  position{line: 324, col: 85, offset: 7909},
 						name: "IntRandExprUnaryMinus",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 293, col: 95, offset: 7000},
+						pos:  position{line: 324, col: 109, offset: 7933},
 						name: "ParenthesizedIntRandExpr",
 					},
 				},
@@ -1436,28 +1642,28 @@ var g = &grammar{
 		},
 		{
 			name: "ParenthesizedIntRandExpr",
-			pos:  position{line: 295, col: 1, offset: 7026},
+			pos:  position{line: 326, col: 1, offset: 7959},
 			expr: &actionExpr{
-				pos: position{line: 295, col: 29, offset: 7054},
+				pos: position{line: 326, col: 29, offset: 7987},
 				run: (*parser).callonParenthesizedIntRandExpr1,
 				expr: &seqExpr{
-					pos: position{line: 295, col: 29, offset: 7054},
+					pos: position{line: 326, col: 29, offset: 7987},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 295, col: 29, offset: 7054},
+							pos:        position{line: 326, col: 29, offset: 7987},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 295, col: 33, offset: 7058},
+							pos:   position{line: 326, col: 33, offset: 7991},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 295, col: 35, offset: 7060},
+								pos:  position{line: 326, col: 35, offset: 7993},
 								name: "IntRandExpr",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 295, col: 47, offset: 7072},
+							pos:        position{line: 326, col: 47, offset: 8005},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -1467,23 +1673,23 @@ var g = &grammar{
 		},
 		{
 			name: "IntRandExprUnaryPlus",
-			pos:  position{line: 299, col: 1, offset: 7107},
+			pos:  position{line: 330, col: 1, offset: 8040},
 			expr: &actionExpr{
-				pos: position{line: 299, col: 25, offset: 7131},
+				pos: position{line: 330, col: 25, offset: 8064},
 				run: (*parser).callonIntRandExprUnaryPlus1,
 				expr: &seqExpr{
-					pos: position{line: 299, col: 25, offset: 7131},
+					pos: position{line: 330, col: 25, offset: 8064},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 299, col: 25, offset: 7131},
+							pos:        position{line: 330, col: 25, offset: 8064},
 							val:        "+",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 299, col: 29, offset: 7135},
+							pos:   position{line: 330, col: 29, offset: 8068},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 299, col: 31, offset: 7137},
+								pos:  position{line: 330, col: 31, offset: 8070},
 								name: "IntRandExprPrimary",
 							},
 						},
@@ -1493,23 +1699,23 @@ var g = &grammar{
 		},
 		{
 			name: "IntRandExprUnaryMinus",
-			pos:  position{line: 303, col: 1, offset: 7187},
+			pos:  position{line: 334, col: 1, offset: 8120},
 			expr: &actionExpr{
-				pos: position{line: 303, col: 26, offset: 7212},
+				pos: position{line: 334, col: 26, offset: 8145},
 				run: (*parser).callonIntRandExprUnaryMinus1,
 				expr: &seqExpr{
-					pos: position{line: 303, col: 26, offset: 7212},
+					pos: position{line: 334, col: 26, offset: 8145},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 303, col: 26, offset: 7212},
+							pos:        position{line: 334, col: 26, offset: 8145},
 							val:        "-",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 303, col: 30, offset: 7216},
+							pos:   position{line: 334, col: 30, offset: 8149},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 303, col: 32, offset: 7218},
+								pos:  position{line: 334, col: 32, offset: 8151},
 								name: "IntRandExprPrimary",
 							},
 						},
@@ -1519,74 +1725,276 @@ var g = &grammar{
 		},
 		{
 			name: "DRoll",
-			pos:  position{line: 307, col: 1, offset: 7287},
+			pos:  position{line: 338, col: 1, offset: 8220},
 			expr: &actionExpr{
-				pos: position{line: 307, col: 10, offset: 7296},
+				pos: position{line: 338, col: 10, offset: 8229},
 				run: (*parser).callonDRoll1,
 				expr: &seqExpr{
-					pos: position{line: 307, col: 10, offset: 7296},
+					pos: position{line: 338, col: 10, offset: 8229},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 307, col: 10, offset: 7296},
+							pos:   position{line: 338, col: 10, offset: 8229},
 							label: "num",
 							expr: &ruleRefExpr{
-								pos:  position{line: 307, col: 14, offset: 7300},
+								pos:  position{line: 338, col: 14, offset: 8233},
 								name: "RollOperand",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 307, col: 26, offset: 7312},
+							pos:        position{line: 338, col: 26, offset: 8245},
 							val:        "d",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 307, col: 31, offset: 7317},
+							pos:   position{line: 338, col: 31, offset: 8250},
 							label: "sides",
 							expr: &ruleRefExpr{
-								pos:  position{line: 307, col: 37, offset: 7323},
+								pos:  position{line: 338, col: 37, offset: 8256},
 								name: "RollOperand",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 307, col: 49, offset: 7335},
+							pos:  position{line: 338, col: 49, offset: 8268},
 							name: "IncRandCount",
 						},
 					},
 				},
 			},
 		},
+		{
+			name: "KeepDropDRoll",
+			pos:  position{line: 345, col: 1, offset: 8391},
+			expr: &actionExpr{
+				pos: position{line: 345, col: 18, offset: 8408},
+				run: (*parser).callonKeepDropDRoll1,
+				expr: &seqExpr{
+					pos: position{line: 345, col: 18, offset: 8408},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 345, col: 18, offset: 8408},
+							label: "dRoll",
+							expr: &ruleRefExpr{
+								pos:  position{line: 345, col: 24, offset: 8414},
+								name: "DRoll",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 345, col: 30, offset: 8420},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 345, col: 33, offset: 8423},
+								name: "KeepDropOp",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 345, col: 44, offset: 8434},
+							label: "count",
+							expr: &ruleRefExpr{
+								pos:  position{line: 345, col: 50, offset: 8440},
+								name: "RollOperand",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "KeepDropOp",
+			pos:  position{line: 363, col: 1, offset: 8899},
+			expr: &choiceExpr{
+				pos: position{line: 363, col: 15, offset: 8913},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 363, col: 15, offset: 8913},
+						val:        "kh",
+						ignoreCase: true,
+					},
+					&litMatcher{
+						pos:        position{line: 363, col: 23, offset: 8921},
+						val:        "kl",
+						ignoreCase: true,
+					},
+					&litMatcher{
+						pos:        position{line: 363, col: 31, offset: 8929},
+						val:        "dh",
+						ignoreCase: true,
+					},
+					&litMatcher{
+						pos:        position{line: 363, col: 39, offset: 8937},
+						val:        "dl",
+						ignoreCase: true,
+					},
+				},
+			},
+		},
+		{
+			name: "ExplodeDRoll",
+			pos:  position{line: 365, col: 1, offset: 8944},
+			expr: &actionExpr{
+				pos: position{line: 365, col: 17, offset: 8960},
+				run: (*parser).callonExplodeDRoll1,
+				expr: &seqExpr{
+					pos: position{line: 365, col: 17, offset: 8960},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 365, col: 17, offset: 8960},
+							label: "dRoll",
+							expr: &ruleRefExpr{
+								pos:  position{line: 365, col: 23, offset: 8966},
+								name: "DRoll",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 365, col: 29, offset: 8972},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 365, col: 32, offset: 8975},
+								name: "ExplodeOp",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 365, col: 42, offset: 8985},
+							label: "threshold",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 365, col: 52, offset: 8995},
+								expr: &seqExpr{
+									pos: position{line: 365, col: 53, offset: 8996},
+									exprs: []interface{}{
+										&litMatcher{
+											pos:        position{line: 365, col: 53, offset: 8996},
+											val:        ">=",
+											ignoreCase: false,
+										},
+										&ruleRefExpr{
+											pos:  position{line: 365, col: 58, offset: 9001},
+											name: "RollOperand",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ExplodeOp",
+			pos:  position{line: 380, col: 1, offset: 9365},
+			expr: &choiceExpr{
+				pos: position{line: 380, col: 14, offset: 9378},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 380, col: 14, offset: 9378},
+						val:        "!!",
+						ignoreCase: false,
+					},
+					&litMatcher{
+						pos:        position{line: 380, col: 21, offset: 9385},
+						val:        "!",
+						ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "RerollDRoll",
+			pos:  position{line: 382, col: 1, offset: 9390},
+			expr: &actionExpr{
+				pos: position{line: 382, col: 16, offset: 9405},
+				run: (*parser).callonRerollDRoll1,
+				expr: &seqExpr{
+					pos: position{line: 382, col: 16, offset: 9405},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 382, col: 16, offset: 9405},
+							label: "dRoll",
+							expr: &ruleRefExpr{
+								pos:  position{line: 382, col: 22, offset: 9411},
+								name: "DRoll",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 382, col: 28, offset: 9417},
+							label: "op",
+							expr: &ruleRefExpr{
+								pos:  position{line: 382, col: 31, offset: 9420},
+								name: "RerollOp",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 382, col: 40, offset: 9429},
+							label: "cmp",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 382, col: 44, offset: 9433},
+								expr: &ruleRefExpr{
+									pos:  position{line: 382, col: 44, offset: 9433},
+									name: "CompareOp",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 382, col: 55, offset: 9444},
+							label: "threshold",
+							expr: &ruleRefExpr{
+								pos:  position{line: 382, col: 65, offset: 9454},
+								name: "RollOperand",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RerollOp",
+			pos:  position{line: 398, col: 1, offset: 9804},
+			expr: &choiceExpr{
+				pos: position{line: 398, col: 13, offset: 9816},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 398, col: 13, offset: 9816},
+						val:        "ro",
+						ignoreCase: true,
+					},
+					&litMatcher{
+						pos:        position{line: 398, col: 21, offset: 9824},
+						val:        "r",
+						ignoreCase: true,
+					},
+				},
+			},
+		},
 		{
 			name: "BRoll",
-			pos:  position{line: 314, col: 1, offset: 7458},
+			pos:  position{line: 400, col: 1, offset: 9830},
 			expr: &actionExpr{
-				pos: position{line: 314, col: 10, offset: 7467},
+				pos: position{line: 400, col: 10, offset: 9839},
 				run: (*parser).callonBRoll1,
 				expr: &seqExpr{
-					pos: position{line: 314, col: 10, offset: 7467},
+					pos: position{line: 400, col: 10, offset: 9839},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 314, col: 10, offset: 7467},
+							pos:   position{line: 400, col: 10, offset: 9839},
 							label: "num",
 							expr: &ruleRefExpr{
-								pos:  position{line: 314, col: 14, offset: 7471},
+								pos:  position{line: 400, col: 14, offset: 9843},
 								name: "RollOperand",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 314, col: 26, offset: 7483},
+							pos:        position{line: 400, col: 26, offset: 9855},
 							val:        "b",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 314, col: 31, offset: 7488},
+							pos:   position{line: 400, col: 31, offset: 9860},
 							label: "sides",
 							expr: &ruleRefExpr{
-								pos:  position{line: 314, col: 37, offset: 7494},
+								pos:  position{line: 400, col: 37, offset: 9866},
 								name: "RollOperand",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 314, col: 49, offset: 7506},
+							pos:  position{line: 400, col: 49, offset: 9878},
 							name: "IncRandCount",
 						},
 					},
@@ -1595,36 +2003,36 @@ var g = &grammar{
 		},
 		{
 			name: "RRoll",
-			pos:  position{line: 321, col: 1, offset: 7629},
+			pos:  position{line: 407, col: 1, offset: 10001},
 			expr: &actionExpr{
-				pos: position{line: 321, col: 10, offset: 7638},
+				pos: position{line: 407, col: 10, offset: 10010},
 				run: (*parser).callonRRoll1,
 				expr: &seqExpr{
-					pos: position{line: 321, col: 10, offset: 7638},
+					pos: position{line: 407, col: 10, offset: 10010},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 321, col: 10, offset: 7638},
+							pos:   position{line: 407, col: 10, offset: 10010},
 							label: "num",
 							expr: &ruleRefExpr{
-								pos:  position{line: 321, col: 14, offset: 7642},
+								pos:  position{line: 407, col: 14, offset: 10014},
 								name: "RollOperand",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 321, col: 26, offset: 7654},
+							pos:        position{line: 407, col: 26, offset: 10026},
 							val:        "r",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 321, col: 31, offset: 7659},
+							pos:   position{line: 407, col: 31, offset: 10031},
 							label: "sides",
 							expr: &ruleRefExpr{
-								pos:  position{line: 321, col: 37, offset: 7665},
+								pos:  position{line: 407, col: 37, offset: 10037},
 								name: "RollOperand",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 321, col: 49, offset: 7677},
+							pos:  position{line: 407, col: 49, offset: 10049},
 							name: "IncRandCount",
 						},
 					},
@@ -1633,36 +2041,36 @@ var g = &grammar{
 		},
 		{
 			name: "URoll",
-			pos:  position{line: 328, col: 1, offset: 7800},
+			pos:  position{line: 414, col: 1, offset: 10172},
 			expr: &actionExpr{
-				pos: position{line: 328, col: 10, offset: 7809},
+				pos: position{line: 414, col: 10, offset: 10181},
 				run: (*parser).callonURoll1,
 				expr: &seqExpr{
-					pos: position{line: 328, col: 10, offset: 7809},
+					pos: position{line: 414, col: 10, offset: 10181},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 328, col: 10, offset: 7809},
+							pos:   position{line: 414, col: 10, offset: 10181},
 							label: "num",
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 14, offset: 7813},
+								pos:  position{line: 414, col: 14, offset: 10185},
 								name: "RollOperand",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 26, offset: 7825},
+							pos:        position{line: 414, col: 26, offset: 10197},
 							val:        "u",
 							ignoreCase: true,
 						},
 						&labeledExpr{
-							pos:   position{line: 328, col: 31, offset: 7830},
+							pos:   position{line: 414, col: 31, offset: 10202},
 							label: "sides",
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 37, offset: 7836},
+								pos:  position{line: 414, col: 37, offset: 10208},
 								name: "RollOperand",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 49, offset: 7848},
+							pos:  position{line: 414, col: 49, offset: 10220},
 							name: "IncRandCount",
 						},
 					},
@@ -1671,20 +2079,24 @@ var g = &grammar{
 		},
 		{
 			name: "RollOperand",
-			pos:  position{line: 335, col: 1, offset: 7971},
+			pos:  position{line: 421, col: 1, offset: 10343},
 			expr: &choiceExpr{
-				pos: position{line: 335, col: 16, offset: 7986},
+				pos: position{line: 421, col: 16, offset: 10358},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 335, col: 16, offset: 7986},
+						pos:  position{line: 421, col: 16, offset: 10358},
 						name: "Integer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 335, col: 26, offset: 7996},
+						pos:  position{line: 421, col: 26, offset: 10368},
+						name: "VariableRef",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 421, col: 40, offset: 10382},
 						name: "RandomNumber",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 335, col: 41, offset: 8011},
+						pos:  position{line: 421, col: 55, offset: 10397},
 						name: "ParenthesizedIntRandExpr",
 					},
 				},
@@ -1692,46 +2104,46 @@ var g = &grammar{
 		},
 		{
 			name: "RandomNumber",
-			pos:  position{line: 337, col: 1, offset: 8037},
+			pos:  position{line: 423, col: 1, offset: 10423},
 			expr: &actionExpr{
-				pos: position{line: 337, col: 17, offset: 8053},
+				pos: position{line: 423, col: 17, offset: 10439},
 				run: (*parser).callonRandomNumber1,
 				expr: &seqExpr{
-					pos: position{line: 337, col: 17, offset: 8053},
+					pos: position{line: 423, col: 17, offset: 10439},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 337, col: 17, offset: 8053},
+							pos:        position{line: 423, col: 17, offset: 10439},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 337, col: 21, offset: 8057},
+							pos:   position{line: 423, col: 21, offset: 10443},
 							label: "min",
 							expr: &ruleRefExpr{
-								pos:  position{line: 337, col: 25, offset: 8061},
+								pos:  position{line: 423, col: 25, offset: 10447},
 								name: "RandomNumberOperand",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 337, col: 45, offset: 8081},
+							pos:        position{line: 423, col: 45, offset: 10467},
 							val:        "...",
 							ignoreCase: false,
 						},
 						&labeledExpr{
-							pos:   position{line: 337, col: 51, offset: 8087},
+							pos:   position{line: 423, col: 51, offset: 10473},
 							label: "max",
 							expr: &ruleRefExpr{
-								pos:  position{line: 337, col: 55, offset: 8091},
+								pos:  position{line: 423, col: 55, offset: 10477},
 								name: "RandomNumberOperand",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 337, col: 75, offset: 8111},
+							pos:        position{line: 423, col: 75, offset: 10497},
 							val:        "]",
 							ignoreCase: false,
 						},
 						&ruleRefExpr{
-							pos:  position{line: 337, col: 79, offset: 8115},
+							pos:  position{line: 423, col: 79, offset: 10501},
 							name: "IncRandCount",
 						},
 					},
@@ -1740,16 +2152,20 @@ var g = &grammar{
 		},
 		{
 			name: "RandomNumberOperand",
-			pos:  position{line: 344, col: 1, offset: 8239},
+			pos:  position{line: 430, col: 1, offset: 10625},
 			expr: &choiceExpr{
-				pos: position{line: 344, col: 24, offset: 8262},
+				pos: position{line: 430, col: 24, offset: 10648},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 344, col: 24, offset: 8262},
+						pos:  position{line: 430, col: 24, offset: 10648},
 						name: "Integer",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 344, col: 34, offset: 8272},
+						pos:  position{line: 430, col: 34, offset: 10658},
+						name: "VariableRef",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 430, col: 48, offset: 10672},
 						name: "ParenthesizedIntExpr",
 					},
 				},
@@ -1757,30 +2173,30 @@ var g = &grammar{
 		},
 		{
 			name: "ResetRandCount",
-			pos:  position{line: 346, col: 1, offset: 8294},
+			pos:  position{line: 432, col: 1, offset: 10694},
 			expr: &stateCodeExpr{
-				pos: position{line: 346, col: 19, offset: 8312},
+				pos: position{line: 432, col: 19, offset: 10712},
 				run: (*parser).callonResetRandCount1,
 			},
 		},
 		{
 			name: "IncRandCount",
-			pos:  position{line: 351, col: 1, offset: 8356},
+			pos:  position{line: 437, col: 1, offset: 10756},
 			expr: &stateCodeExpr{
-				pos: position{line: 351, col: 17, offset: 8372},
+				pos: position{line: 437, col: 17, offset: 10772},
 				run: (*parser).callonIncRandCount1,
 			},
 		},
 		{
 			name: "Integer",
-			pos:  position{line: 356, col: 1, offset: 8445},
+			pos:  position{line: 442, col: 1, offset: 10845},
 			expr: &actionExpr{
-				pos: position{line: 356, col: 12, offset: 8456},
+				pos: position{line: 442, col: 12, offset: 10856},
 				run: (*parser).callonInteger1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 356, col: 12, offset: 8456},
+					pos: position{line: 442, col: 12, offset: 10856},
 					expr: &charClassMatcher{
-						pos:        position{line: 356, col: 12, offset: 8456},
+						pos:        position{line: 442, col: 12, offset: 10856},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -1789,39 +2205,102 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "VariableRef",
+			pos:  position{line: 451, col: 1, offset: 11025},
+			expr: &actionExpr{
+				pos: position{line: 451, col: 16, offset: 11040},
+				run: (*parser).callonVariableRef1,
+				expr: &seqExpr{
+					pos: position{line: 451, col: 16, offset: 11040},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 451, col: 16, offset: 11040},
+							val:        "{",
+							ignoreCase: false,
+						},
+						&labeledExpr{
+							pos:   position{line: 451, col: 20, offset: 11044},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 451, col: 25, offset: 11049},
+								name: "Identifier",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 451, col: 36, offset: 11060},
+							val:        "}",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Identifier",
+			pos:  position{line: 455, col: 1, offset: 11116},
+			expr: &actionExpr{
+				pos: position{line: 455, col: 15, offset: 11130},
+				run: (*parser).callonIdentifier1,
+				expr: &seqExpr{
+					pos: position{line: 455, col: 15, offset: 11130},
+					exprs: []interface{}{
+						&charClassMatcher{
+							pos:        position{line: 455, col: 15, offset: 11130},
+							val:        "[A-Za-z_]",
+							chars:      []rune{'_'},
+							ranges:     []rune{'A', 'Z', 'a', 'z'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 455, col: 25, offset: 11140},
+							expr: &charClassMatcher{
+								pos:        position{line: 455, col: 25, offset: 11140},
+								val:        "[A-Za-z0-9_]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'A', 'Z', 'a', 'z', '0', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "CompareOp",
-			pos:  position{line: 365, col: 1, offset: 8625},
+			pos:  position{line: 459, col: 1, offset: 11187},
 			expr: &choiceExpr{
-				pos: position{line: 365, col: 14, offset: 8638},
+				pos: position{line: 459, col: 14, offset: 11200},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 365, col: 14, offset: 8638},
+						pos:        position{line: 459, col: 14, offset: 11200},
 						val:        "=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 365, col: 20, offset: 8644},
+						pos:        position{line: 459, col: 20, offset: 11206},
 						val:        "<>",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 365, col: 27, offset: 8651},
+						pos:        position{line: 459, col: 27, offset: 11213},
 						val:        "<=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 365, col: 34, offset: 8658},
+						pos:        position{line: 459, col: 34, offset: 11220},
 						val:        "<",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 365, col: 40, offset: 8664},
+						pos:        position{line: 459, col: 40, offset: 11226},
 						val:        ">=",
 						ignoreCase: false,
 					},
 					&litMatcher{
-						pos:        position{line: 365, col: 47, offset: 8671},
+						pos:        position{line: 459, col: 47, offset: 11233},
 						val:        ">",
 						ignoreCase: false,
 					},
@@ -1830,11 +2309,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOT",
-			pos:  position{line: 367, col: 1, offset: 8676},
+			pos:  position{line: 461, col: 1, offset: 11238},
 			expr: &notExpr{
-				pos: position{line: 367, col: 8, offset: 8683},
+				pos: position{line: 461, col: 8, offset: 11245},
 				expr: &anyMatcher{
-					line: 367, col: 9, offset: 8684,
+					line: 461, col: 9, offset: 11246,
 				},
 			},
 		},
@@ -1861,6 +2340,36 @@ func (p *parser) callonCommandWithExpression1() (interface{}, error) {
 	return p.cur.onCommandWithExpression1(stack["n"])
 }
 
+func (c *current) onAssignment1(name, expr interface{}) (interface{}, error) {
+	return ast.NewAssign(name.(string), expr.(ast.Node)), nil
+}
+
+func (p *parser) callonAssignment1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAssignment1(stack["name"], stack["expr"])
+}
+
+func (c *current) onMacroDefinition1(name, body interface{}) (interface{}, error) {
+	return ast.NewMacroDefinition(name.(string), body.(string)), nil
+}
+
+func (p *parser) callonMacroDefinition1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMacroDefinition1(stack["name"], stack["body"])
+}
+
+func (c *current) onMacroBody1() (interface{}, error) {
+	return strings.TrimSpace(string(c.text)), nil
+}
+
+func (p *parser) callonMacroBody1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMacroBody1()
+}
+
 func (c *current) onChoice1(items interface{}) (interface{}, error) {
 	return items, nil
 }
@@ -1911,6 +2420,27 @@ func (p *parser) callonChoiceItemChars1() (interface{}, error) {
 	return p.cur.onChoiceItemChars1()
 }
 
+func (c *current) onD661(order interface{}) (interface{}, error) {
+	if order == nil {
+		return ast.NewD66(ast.D66_ORDER_UNSPECIFIED), nil
+	}
+
+	switch strings.ToUpper(string(order.([]byte))) {
+	case "N":
+		return ast.NewD66(ast.D66_ORDER_NONE), nil
+	case "S":
+		return ast.NewD66(ast.D66_ORDER_ASCENDING), nil
+	}
+
+	return nil, fmt.Errorf("unknown D66 order: %s", order)
+}
+
+func (p *parser) callonD661() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onD661(stack["order"])
+}
+
 func (c *current) onCalc1(expr interface{}) (interface{}, error) {
 	return ast.NewCalc(expr.(ast.Node)), nil
 }
@@ -1967,7 +2497,11 @@ func (p *parser) callonBRollList1() (interface{}, error) {
 	return p.cur.onBRollList1(stack["first"], stack["rest"])
 }
 
-func (c *current) onBRollComp1(left, op, right interface{}) (interface{}, error) {
+func (c *current) onBRollComp1(left, op, right, botch interface{}) (interface{}, error) {
+	if botches := toIfaceSlice(botch); botches != nil {
+		left.(*ast.BRollList).BotchThreshold = botches[1].(ast.Node)
+	}
+
 	return ast.NewBRollComp(
 		ast.NewCompare(
 			left.(ast.Node),
@@ -1980,7 +2514,7 @@ func (c *current) onBRollComp1(left, op, right interface{}) (interface{}, error)
 func (p *parser) callonBRollComp1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onBRollComp1(stack["left"], stack["op"], stack["right"])
+	return p.cur.onBRollComp1(stack["left"], stack["op"], stack["right"], stack["botch"])
 }
 
 func (c *current) onRRollList1(first, rest, th interface{}) (interface{}, error) {
@@ -2269,6 +2803,73 @@ func (p *parser) callonDRoll1() (interface{}, error) {
 	return p.cur.onDRoll1(stack["num"], stack["sides"])
 }
 
+func (c *current) onKeepDropDRoll1(dRoll, op, count interface{}) (interface{}, error) {
+	dRollNode := dRoll.(ast.Node)
+	countNode := count.(ast.Node)
+
+	switch strings.ToUpper(string(op.([]byte))) {
+	case "KH":
+		return ast.NewKeepHighest(dRollNode, countNode), nil
+	case "KL":
+		return ast.NewKeepLowest(dRollNode, countNode), nil
+	case "DH":
+		return ast.NewDropHighest(dRollNode, countNode), nil
+	case "DL":
+		return ast.NewDropLowest(dRollNode, countNode), nil
+	}
+
+	return nil, fmt.Errorf("unknown keep/drop operator: %s", op)
+}
+
+func (p *parser) callonKeepDropDRoll1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onKeepDropDRoll1(stack["dRoll"], stack["op"], stack["count"])
+}
+
+func (c *current) onExplodeDRoll1(dRoll, op, threshold interface{}) (interface{}, error) {
+	dRollNode := dRoll.(ast.Node)
+
+	var thresholdNode ast.Node = ast.NilInstance()
+	if thresholds := toIfaceSlice(threshold); thresholds != nil {
+		thresholdNode = thresholds[1].(ast.Node)
+	}
+
+	if string(op.([]byte)) == "!!" {
+		return ast.NewCompoundExplode(dRollNode, thresholdNode), nil
+	}
+
+	return ast.NewExplode(dRollNode, thresholdNode), nil
+}
+
+func (p *parser) callonExplodeDRoll1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExplodeDRoll1(stack["dRoll"], stack["op"], stack["threshold"])
+}
+
+func (c *current) onRerollDRoll1(dRoll, op, cmp, threshold interface{}) (interface{}, error) {
+	dRollNode := dRoll.(ast.Node)
+	thresholdNode := threshold.(ast.Node)
+
+	compareOp := "="
+	if cmp != nil {
+		compareOp = string(cmp.([]byte))
+	}
+
+	if strings.ToUpper(string(op.([]byte))) == "RO" {
+		return ast.NewRerollOnce(dRollNode, compareOp, thresholdNode), nil
+	}
+
+	return ast.NewReroll(dRollNode, compareOp, thresholdNode), nil
+}
+
+func (p *parser) callonRerollDRoll1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRerollDRoll1(stack["dRoll"], stack["op"], stack["cmp"], stack["threshold"])
+}
+
 func (c *current) onBRoll1(num, sides interface{}) (interface{}, error) {
 	numNode := num.(ast.Node)
 	sidesNode := sides.(ast.Node)
@@ -2358,6 +2959,26 @@ func (p *parser) callonInteger1() (interface{}, error) {
 	return p.cur.onInteger1()
 }
 
+func (c *current) onVariableRef1(name interface{}) (interface{}, error) {
+	return ast.NewVariableRef(name.(string)), nil
+}
+
+func (p *parser) callonVariableRef1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVariableRef1(stack["name"])
+}
+
+func (c *current) onIdentifier1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonIdentifier1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIdentifier1()
+}
+
 var (
 	// errNoRule is returned when the grammar to parse has no rule.
 	errNoRule = errors.New("grammar has no rule")
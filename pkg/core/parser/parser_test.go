@@ -148,6 +148,42 @@ func TestParse(t *testing.T) {
 		{"(1+1)d[1...5]", "(DRollExpr (DRoll (+ 1 1) (RandomNumber 1 5)))", false},
 		{"([1...4]+1)d([2...4]+2)-1", "(DRollExpr (- (DRoll (+ (RandomNumber 1 4) 1) (+ (RandomNumber 2 4) 2)) 1))", false},
 
+		// 出目を残す/捨てる加算ロール
+		{"4D6KH3", "(DRollExpr (KeepHighest (DRoll 4 6) 3))", false},
+		{"2d20kl1", "(DRollExpr (KeepLowest (DRoll 2 20) 1))", false},
+		{"4D6DH1", "(DRollExpr (DropHighest (DRoll 4 6) 1))", false},
+		{"4d6dl1", "(DRollExpr (DropLowest (DRoll 4 6) 1))", false},
+		{"4D6KH3+2", "(DRollExpr (+ (KeepHighest (DRoll 4 6) 3) 2))", false},
+		{"2D20KH1+2D6", "(DRollExpr (+ (KeepHighest (DRoll 2 20) 1) (DRoll 2 6)))", false},
+		{"(2+2)D6KH(1+2)", "(DRollExpr (KeepHighest (DRoll (+ 2 2) 6) (+ 1 2)))", false},
+		{"4D6KH[1...3]", "(DRollExpr (KeepHighest (DRoll 4 6) (RandomNumber 1 3)))", false},
+		{"4D6KH3>=10", "(DRollComp (>= (KeepHighest (DRoll 4 6) 3) 10))", false},
+		{"4D6KH", "", true},
+		{"4D6KH3KL1", "", true},
+		{"4D6K3", "", true},
+
+		// 振り足しつき加算ロール
+		{"1D6!", "(DRollExpr (Explode (DRoll 1 6) nil))", false},
+		{"2d6!>=5", "(DRollExpr (Explode (DRoll 2 6) 5))", false},
+		{"2D6!!", "(DRollExpr (CompoundExplode (DRoll 2 6) nil))", false},
+		{"2D6!!>=(2+3)", "(DRollExpr (CompoundExplode (DRoll 2 6) (+ 2 3)))", false},
+		{"2D6!+1D6!", "(DRollExpr (+ (Explode (DRoll 2 6) nil) (Explode (DRoll 1 6) nil)))", false},
+		{"2D6!>=5>=10", "(DRollComp (>= (Explode (DRoll 2 6) 5) 10))", false},
+		{"2D6!>=10", "(DRollExpr (Explode (DRoll 2 6) 10))", false},
+		{"2D6!>=", "", true},
+		{"2D6!!!", "", true},
+		{"2D6!KH1", "", true},
+
+		// 振り直しつき加算ロール
+		{"2D6r1", "(DRollExpr (Reroll (DRoll 2 6) = 1))", false},
+		{"4d6ro<3", "(DRollExpr (RerollOnce (DRoll 4 6) < 3))", false},
+		{"2D6R<=(1+1)", "(DRollExpr (Reroll (DRoll 2 6) <= (+ 1 1)))", false},
+		{"2D6r1+3", "(DRollExpr (+ (Reroll (DRoll 2 6) = 1) 3))", false},
+		{"2D6r1>=7", "(DRollComp (>= (Reroll (DRoll 2 6) = 1) 7))", false},
+		{"1D100/10r", "(DRollExpr (/R (DRoll 1 100) 10))", false},
+		{"2D6r", "", true},
+		{"2D6ro", "", true},
+
 		// 加算ロール式の成功判定
 		{"2d6=7", "(DRollComp (= (DRoll 2 6) 7))", false},
 		{"2d6<>7", "(DRollComp (<> (DRoll 2 6) 7))", false},
@@ -191,6 +227,9 @@ func TestParse(t *testing.T) {
 		{"2b6>4-1", "(BRollComp (> (BRollList (BRoll 2 6)) (- 4 1)))", false},
 		{"2b6+4b10>4", "(BRollComp (> (BRollList (BRoll 2 6) (BRoll 4 10)) 4))", false},
 		{"2b6>-(-1*3)", "(BRollComp (> (BRollList (BRoll 2 6)) (- (* (- 1) 3))))", false},
+		{"10b10>=8[1]", "(BRollComp (>= (BRollList (BRoll 10 10) (Botch 1)) 8))", false},
+		{"10b10>=8[1+1]", "(BRollComp (>= (BRollList (BRoll 10 10) (Botch (+ 1 1))) 8))", false},
+		{"10b10>=8[]", "", true},
 		{"2b6+1>3", "", true},
 		{"1+2b6>3", "", true},
 		{"3<2b6", "", true},
@@ -239,6 +278,17 @@ func TestParse(t *testing.T) {
 		{"(5+6)u10[10]+5>=8", "(URollComp (>= (URollExpr (+ (RRollList 10 (URoll (+ 5 6) 10)) 5)) 8))", false},
 		{"5<3u6[6]<10", "", true},
 
+		// D66ダイス
+		{"D66", "(D66)", false},
+		{"d66", "(D66)", false},
+		{"D66N", "(D66 N)", false},
+		{"d66n", "(D66 N)", false},
+		{"D66S", "(D66 S)", false},
+		{"d66s", "(D66 S)", false},
+		{"D66A", "", true},
+		{"D66+1", "", true},
+		{"2D66", "(DRollExpr (DRoll 2 66))", false},
+
 		// ランダム選択
 		{"choice[A,B,C]どれにしよう", `(Choice "A" "B" "C")`, false},
 		{"choice[A,B, ]", `(Choice "A" "B")`, false},
@@ -255,6 +305,33 @@ func TestParse(t *testing.T) {
 		},
 		{"choice[1+2, (3*4), 5d6]", `(Choice "1+2" "(3*4)" "5d6")`, false},
 		{"choice[forgetting R_BRACKET!", "", true},
+
+		// 変数参照
+		{"{atk}+1D6", "(DRollExpr (+ {atk} (DRoll 1 6)))", false},
+		{"{n}D6", "(DRollExpr (DRoll {n} 6))", false},
+		{"2D6+{Atk_2}>={target}", "(DRollComp (>= (+ (DRoll 2 6) {Atk_2}) {target}))", false},
+		{"2D6-{b}", "(DRollExpr (- (DRoll 2 6) {b}))", false},
+		{"2R6[{th}]", "(RRollList {th} (RRoll 2 6))", false},
+		{"[1...{max}]D6", "(DRollExpr (DRoll (RandomNumber 1 {max}) 6))", false},
+		{"C({a}*2)", "(Calc (* {a} 2))", false},
+		{"{1a}+1D6", "", true},
+		{"{}+1D6", "", true},
+		{"{atk+1D6", "", true},
+
+		// 変数への代入
+		{"atk=2D6+5", "(Assign atk (+ (DRoll 2 6) 5))", false},
+		{"a=5", "(Assign a 5)", false},
+		{"_x1=-{a}*2", "(Assign _x1 (* (- {a}) 2))", false},
+		{"D6=3", "(Assign D6 3)", false},
+		{"atk=", "", true},
+		{"atk=2D6>=7", "", true},
+		{"1a=2", "", true},
+
+		// マクロ定義
+		{"atk:=2D6+5", `(MacroDefinition atk "2D6+5")`, false},
+		{"sanc:= CC<=50 正気度", `(MacroDefinition sanc "CC<=50 正気度")`, false},
+		{"atk:=", "", true},
+		{"atk:=   ", "", true},
 	}
 
 	for _, test := range testCases {
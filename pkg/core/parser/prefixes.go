@@ -0,0 +1,23 @@
+package parser
+
+// BasicCommandPrefixes は、BCDiceの基本コマンドとして解釈できる可能性がある入力の
+// 接頭辞のパターン（正規表現）を返す。
+//
+// 構文解析の前に、入力がコマンドである可能性があるかを素早く判定するために使う。
+// パターンは大文字と小文字を区別せずに入力の先頭と照合することを想定している。
+func BasicCommandPrefixes() []string {
+	return []string{
+		// 加算ロール、バラバラロール、個数振り足しロール、上方無限ロール、計算など
+		`[0-9(\[+\-]`,
+		// 計算コマンド
+		`C\(`,
+		// ランダム選択
+		`CHOICE\[`,
+		// D66ロール
+		`D66`,
+		// 変数参照で始まる式
+		`\{`,
+		// 変数への代入、マクロ定義
+		`[A-Z_][A-Z0-9_]*:?=`,
+	}
+}
@@ -4,12 +4,23 @@
 package dicebot
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
 	"github.com/raa0121/GoBCDice/pkg/core/command"
 	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/table"
 )
 
+// ErrUnknownCommand は、ダイスボットが入力をコマンドとして認識しなかったことを表すエラー。
+//
+// ダイスボットの ExecuteCommand は、入力が固有のコマンドでない場合にこのエラーをラップして返す。
+// それ以外のエラーは、コマンドとして認識したうえで実行に失敗したことを表す。
+var ErrUnknownCommand = errors.New("unknown command")
+
 // ダイスボットを構築する関数の型。
 type DiceBotConstructor func() DiceBot
 
@@ -23,8 +34,19 @@ type DiceBot interface {
 	Usage() string
 	// SortKey は並べ替え順のよみがなを返す。
 	SortKey() string
+	// D66Order はD66ダイスの既定の並べ方を返す。
+	D66Order() ast.D66Order
+	// Prefixes はダイスボットが認識するコマンドの接頭辞のパターン（正規表現）を返す。
+	Prefixes() []string
+	// FindTable は指定されたコマンドで呼び出す表を探す。
+	FindTable(command string) (*table.Table, bool)
 	// ExecuteCommand は指定されたコマンドを実行する。
+	// コマンドを認識しなかった場合は ErrUnknownCommand をラップしたエラーを返す。
 	ExecuteCommand(command string, ev *evaluator.Evaluator) (*command.Result, error)
+	// AdjustSuccessCheckResult は、基本コマンドの実行結果の成功判定結果を、
+	// ゲームシステムに合わせて調整する（例：クリティカルやファンブルへの変更）。
+	// メッセージはevに設定されている言語で整形する。
+	AdjustSuccessCheckResult(result *command.Result, ev *evaluator.Evaluator)
 }
 
 // DiceBotBasicInfo はダイスボットの基本情報を表す構造体。
@@ -37,12 +59,16 @@ type DiceBotBasicInfo struct {
 	Usage string
 	// SortKey は並べ替え順のよみがな。
 	SortKey string
+	// Prefixes はゲームシステム固有のコマンドの接頭辞のパターン（正規表現）。
+	Prefixes []string
 }
 
 // DiceBotImpl はダイスボットの実装のベースとなる構造体。
 type DiceBotImpl struct {
 	// BasicInfo はダイスボットの基本情報。
 	BasicInfo *DiceBotBasicInfo
+	// Tables はダイスボットで使用する表。
+	Tables []*table.Table
 }
 
 // DiceBotImpl がDiceBotを実装していることを確認する。
@@ -67,12 +93,53 @@ func (d *DiceBotImpl) Usage() string {
 func (d *DiceBotImpl)SortKey() string {
 	return d.BasicInfo.SortKey
 }
+// D66Order はD66ダイスの既定の並べ方を返す。
+//
+// 基本のダイスボットでは並べ替えを行わない。
+// 並べ方が異なるゲームシステムでは、このメソッドを上書きする。
+func (d *DiceBotImpl) D66Order() ast.D66Order {
+	return ast.D66_ORDER_NONE
+}
+
+// Prefixes はダイスボットが認識するコマンドの接頭辞のパターン（正規表現）を返す。
+//
+// 基本情報に設定された接頭辞の後に、表を呼び出すコマンドが続く。
+func (d *DiceBotImpl) Prefixes() []string {
+	prefixes := make([]string, 0, len(d.BasicInfo.Prefixes)+len(d.Tables))
+	prefixes = append(prefixes, d.BasicInfo.Prefixes...)
+
+	for _, t := range d.Tables {
+		prefixes = append(prefixes, regexp.QuoteMeta(t.Command))
+	}
+
+	return prefixes
+}
+
+// FindTable は指定されたコマンドで呼び出す表を探す。
+//
+// コマンドの大文字と小文字は区別しない。
+func (d *DiceBotImpl) FindTable(command string) (*table.Table, bool) {
+	for _, t := range d.Tables {
+		if strings.EqualFold(t.Command, command) {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+// AdjustSuccessCheckResult は基本コマンドの実行結果の成功判定結果を調整する。
+//
+// 基本のダイスボットでは何もしない。
+func (d *DiceBotImpl) AdjustSuccessCheckResult(_ *command.Result, _ *evaluator.Evaluator) {
+}
+
 // ExecuteCommand は指定されたコマンドを実行する。
 //
-// 基本のダイスボットには特別なコマンドが存在しないため、必ずエラーを返す。
+// 基本のダイスボットには特別なコマンドが存在しないため、必ず ErrUnknownCommand をラップしたエラーを返す。
 func (b *DiceBotImpl) ExecuteCommand(
-	_ string,
+	c string,
 	_ *evaluator.Evaluator,
 ) (*command.Result, error) {
-	return nil, fmt.Errorf("no game-system-specific command")
+	return nil, fmt.Errorf("%s: %w: %s", b.GameID(), ErrUnknownCommand, c)
 }
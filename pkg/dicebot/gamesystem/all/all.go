@@ -0,0 +1,15 @@
+/*
+すべてのゲームシステムのダイスボットを登録するパッケージ。
+
+このパッケージをインポートすると、gamesystem以下の各パッケージのinit関数が実行され、
+ダイスボットの一覧に各ゲームシステムのダイスボットが登録される。
+
+	import _ "github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/all"
+*/
+package all
+
+import (
+	_ "github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/cthulhu"
+	_ "github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/cthulhu7th"
+	_ "github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/swordworld"
+)
@@ -3,6 +3,7 @@ package basic_test
 import (
 	"testing"
 
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 	"github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/basic"
 	dicebottesting "github.com/raa0121/GoBCDice/pkg/dicebot/testing"
 )
@@ -19,13 +20,24 @@ func TestDiceBot(t *testing.T) {
 		"u_roll_expr.txt",
 		"u_roll_comp.txt",
 		"choice.txt",
+		"d66.txt",
 		"secret_roll.txt",
+		"multiline_input.txt",
 	}
 
 	testDataFiles := dicebottesting.JoinWithTestData(testDataFileBaseNames)
 	dicebottesting.Run("DiceBot", t, testDataFiles...)
 }
 
+func TestDiceBot_English(t *testing.T) {
+	testDataFiles := dicebottesting.JoinWithTestData([]string{
+		"locale_en.txt",
+	})
+
+	dicebottesting.RunWithSetup(
+		"DiceBot", t, dicebottesting.SetLocale(locale.EN), testDataFiles...)
+}
+
 func TestBasic_GameID(t *testing.T) {
 	expected := "DiceBot"
 	actual := basic.New().GameID()
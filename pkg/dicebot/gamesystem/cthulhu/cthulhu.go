@@ -0,0 +1,318 @@
+/*
+クトゥルフ神話TRPG（第6版）のダイスボットのパッケージ。
+*/
+package cthulhu
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+	"github.com/raa0121/GoBCDice/pkg/dicebot"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/list"
+)
+
+// basicInfo はダイスボットの基本情報。
+var basicInfo = dicebot.DiceBotBasicInfo{
+	GameID:   "Cthulhu",
+	GameName: "クトゥルフ神話TRPG",
+	Usage: `c=クリティカル値 ／ f=ファンブル値 ／ s=スペシャル
+
+・cfs判定付き判定コマンド
+　CC　 1d100ロールを行う c=1、f=100
+　CCB  同上、c=5、f=96
+　例：CC<=80  （技能値80で行為判定。1%ルールでcf適用）
+　例：CCB<=55 （技能値55で行為判定。5%ルールでcf適用）
+
+・組み合わせロールについて
+　CBR(x,y)　 c=1、f=100
+　CBRB(x,y)　c=5、f=96
+　例：CBR(50,20)
+
+・抵抗表ロールについて
+　RES(x-n)　 c=1、f=100
+　RESB(x-n)　c=5、f=96
+　※通常の抵抗表ロール。能動側能力値xと受動側能力値nの差で目標値を決定する。
+　例：RES(12-10)　RESB(8-14)
+
+・故障ナンバー判定
+　CC(x)<=n　CCB(x)<=n
+　x=故障ナンバー。出目x以上が出た場合、「故障」を出力する。
+　ファンブルが同時に発生した場合は「致命的失敗/故障」となる。
+　例：CC(97)<=40`,
+	SortKey: "くとうるふしんわTRPG",
+	Prefixes: []string{
+		`CC(B)?(\(\d+\))?(<=\d+)?`,
+		`CBR(B)?\(\d+,\d+\)`,
+		`RES(B)?\(\d+-\d+\)`,
+	},
+}
+
+func init() {
+	list.MustRegister(New, "CoC", "CoC6th")
+}
+
+// BasicInfo はダイスボットの基本情報を返す。
+func BasicInfo() *dicebot.DiceBotBasicInfo {
+	return &basicInfo
+}
+
+// クトゥルフ神話TRPGのダイスボット。
+type Cthulhu struct {
+	dicebot.DiceBotImpl
+}
+
+// Cthulhu がDiceBotを実装していることの確認。
+var _ dicebot.DiceBot = (*Cthulhu)(nil)
+
+// New は新しいダイスボットを構築する。
+func New() dicebot.DiceBot {
+	return &Cthulhu{
+		DiceBotImpl: dicebot.DiceBotImpl{
+			BasicInfo: BasicInfo(),
+		},
+	}
+}
+
+// percentageRule は決定的成功と致命的失敗の範囲を表す構造体。
+type percentageRule struct {
+	// この値以下の出目が決定的成功となる
+	critical int
+	// この値以上の出目が致命的失敗となる
+	fumble int
+}
+
+var (
+	// 1%ルール（CC、CBR、RES）
+	rule1Percent = percentageRule{critical: 1, fumble: 100}
+	// 5%ルール（CCB、CBRB、RESB）
+	rule5Percent = percentageRule{critical: 5, fumble: 96}
+)
+
+// ruleFor は、コマンド末尾の "B" の有無に応じたルールを返す。
+func ruleFor(b string) percentageRule {
+	if b == "" {
+		return rule1Percent
+	}
+
+	return rule5Percent
+}
+
+var (
+	// 1D100による判定のコマンドを表す正規表現
+	checkCommandRe = regexp.MustCompile(`\A(?i:CC(B)?(?:\((\d+)\))?(?:<=(\d+))?)\z`)
+	// 組み合わせロールのコマンドを表す正規表現
+	combinedCommandRe = regexp.MustCompile(`\A(?i:CBR(B)?\((\d+),(\d+)\))\z`)
+	// 抵抗表ロールのコマンドを表す正規表現
+	resistanceCommandRe = regexp.MustCompile(`\A(?i:RES(B)?\((\d+)-(\d+)\))\z`)
+)
+
+// ExecuteCommand は指定されたコマンドを実行する。
+func (c *Cthulhu) ExecuteCommand(
+	input string,
+	ev *evaluator.Evaluator,
+) (*command.Result, error) {
+	if m := checkCommandRe.FindStringSubmatch(input); m != nil {
+		return c.executeCheck(m, ev)
+	}
+
+	if m := combinedCommandRe.FindStringSubmatch(input); m != nil {
+		return c.executeCombinedRoll(m, ev)
+	}
+
+	if m := resistanceCommandRe.FindStringSubmatch(input); m != nil {
+		return c.executeResistanceRoll(m, ev)
+	}
+
+	return nil, fmt.Errorf("%s: %w: %s", c.GameID(), dicebot.ErrUnknownCommand, input)
+}
+
+// executeCheck は1D100による判定を行う。
+func (c *Cthulhu) executeCheck(m []string, ev *evaluator.Evaluator) (*command.Result, error) {
+	rule := ruleFor(m[1])
+
+	total, err := roll1D100(ev)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &command.Result{
+		GameID:     c.GameID(),
+		RolledDice: ev.RolledDice(),
+	}
+
+	if m[3] == "" {
+		// 目標値なし：出目のみを表示する
+		result.AppendMessagePart("(1D100)")
+		result.AppendMessagePart(strconv.Itoa(total))
+
+		return result, nil
+	}
+
+	target, _ := strconv.Atoi(m[3])
+	// 故障ナンバー（0の場合は故障を判定しない）
+	brokenNumber, _ := strconv.Atoi(m[2])
+
+	notation := fmt.Sprintf("(1D100<=%d)", target)
+	if brokenNumber > 0 {
+		notation += " " + ev.Message(msgBrokenNumber, brokenNumber)
+	}
+
+	var textID locale.MessageID
+	result.SuccessCheckResult, textID = rule.checkResult(total, target)
+	text := ev.Message(textID)
+
+	if brokenNumber > 0 && total >= brokenNumber {
+		if total >= rule.fumble {
+			text += "/" + ev.Message(msgBroken)
+		} else {
+			text = ev.Message(msgBroken)
+		}
+	}
+
+	result.AppendMessagePart(notation)
+	result.AppendMessagePart(strconv.Itoa(total))
+	result.AppendMessagePart(text)
+
+	return result, nil
+}
+
+// executeCombinedRoll は組み合わせロールを行う。
+//
+// 1回の出目で2つの目標値に対する判定を行い、
+// 両方成功すれば「成功」、一方のみ成功すれば「部分的成功」、両方失敗すれば「失敗」となる。
+func (c *Cthulhu) executeCombinedRoll(m []string, ev *evaluator.Evaluator) (*command.Result, error) {
+	rule := ruleFor(m[1])
+	target1, _ := strconv.Atoi(m[2])
+	target2, _ := strconv.Atoi(m[3])
+
+	total, err := roll1D100(ev)
+	if err != nil {
+		return nil, err
+	}
+
+	result1, textID1 := rule.checkResult(total, target1)
+	result2, textID2 := rule.checkResult(total, target2)
+	success1 := result1.IsSuccess()
+	success2 := result2.IsSuccess()
+
+	result := &command.Result{
+		GameID:     c.GameID(),
+		RolledDice: ev.RolledDice(),
+	}
+
+	var rank locale.MessageID
+	switch {
+	case success1 && success2:
+		rank = locale.SUCCESS
+		result.SuccessCheckResult = command.SUCCESS_CHECK_SUCCESS
+	case success1 || success2:
+		rank = msgPartialSuccess
+		result.SuccessCheckResult = command.SUCCESS_CHECK_SUCCESS
+	default:
+		rank = locale.FAILURE
+		result.SuccessCheckResult = command.SUCCESS_CHECK_FAILURE
+	}
+
+	result.AppendMessagePart(fmt.Sprintf("(1d100<=%d,%d)", target1, target2))
+	result.AppendMessagePart(fmt.Sprintf("%d[%s,%s]",
+		total, ev.Message(textID1), ev.Message(textID2)))
+	result.AppendMessagePart(ev.Message(rank))
+
+	return result, nil
+}
+
+// executeResistanceRoll は抵抗表ロールを行う。
+//
+// 目標値は、能動側と受動側の能力値の差を5倍して50を加えたもの。
+// 目標値が5未満であれば自動失敗、95を超えれば自動成功となり、ダイスは振らない。
+func (c *Cthulhu) executeResistanceRoll(m []string, ev *evaluator.Evaluator) (*command.Result, error) {
+	rule := ruleFor(m[1])
+	active, _ := strconv.Atoi(m[2])
+	passive, _ := strconv.Atoi(m[3])
+
+	target := (active-passive)*5 + 50
+	notation := fmt.Sprintf("(1d100<=%d)", target)
+
+	result := &command.Result{
+		GameID: c.GameID(),
+	}
+
+	if target < 5 {
+		result.SuccessCheckResult = command.SUCCESS_CHECK_AUTO_FAILURE
+		result.AppendMessagePart(notation)
+		result.AppendMessagePart(ev.Message(msgAutoFailure))
+
+		return result, nil
+	}
+
+	if target > 95 {
+		result.SuccessCheckResult = command.SUCCESS_CHECK_AUTO_SUCCESS
+		result.AppendMessagePart(notation)
+		result.AppendMessagePart(ev.Message(msgAutoSuccess))
+
+		return result, nil
+	}
+
+	total, err := roll1D100(ev)
+	if err != nil {
+		return nil, err
+	}
+
+	var textID locale.MessageID
+	result.SuccessCheckResult, textID = rule.checkResult(total, target)
+
+	result.RolledDice = ev.RolledDice()
+	result.AppendMessagePart(notation)
+	result.AppendMessagePart(strconv.Itoa(total))
+	result.AppendMessagePart(ev.Message(textID))
+
+	return result, nil
+}
+
+// checkResult は、出目totalと目標値targetから判定結果を求める。
+// 返り値は成功判定結果と、判定結果のメッセージID。
+//
+// 100以外の目標値以下の出目は成功となり、致命的失敗の範囲は失敗した場合のみ判定する。
+// スペシャルとなる値は目標値の1/5（切り捨て）で、1未満の場合は1とする。
+func (r percentageRule) checkResult(total int, target int) (command.SuccessCheckResultType, locale.MessageID) {
+	if total <= target && total < 100 {
+		specialValue := target / 5
+		if specialValue < 1 {
+			specialValue = 1
+		}
+
+		critical := total <= r.critical
+		special := total <= specialValue
+
+		switch {
+		case critical && special:
+			return command.SUCCESS_CHECK_CRITICAL, msgCriticalSpecial
+		case critical:
+			return command.SUCCESS_CHECK_CRITICAL, msgCritical
+		case special:
+			return command.SUCCESS_CHECK_SPECIAL, msgSpecial
+		default:
+			return command.SUCCESS_CHECK_SUCCESS, locale.SUCCESS
+		}
+	}
+
+	if total >= r.fumble {
+		return command.SUCCESS_CHECK_FUMBLE, msgFumble
+	}
+
+	return command.SUCCESS_CHECK_FAILURE, locale.FAILURE
+}
+
+// roll1D100 は1D100を振り、出目を返す。
+func roll1D100(ev *evaluator.Evaluator) (int, error) {
+	rolledDice, err := ev.RollDice(1, 100)
+	if err != nil {
+		return 0, err
+	}
+
+	return rolledDice[0].Value, nil
+}
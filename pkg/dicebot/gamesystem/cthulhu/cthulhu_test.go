@@ -0,0 +1,80 @@
+package cthulhu_test
+
+import (
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/bcdice"
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/cthulhu"
+	dicebottesting "github.com/raa0121/GoBCDice/pkg/dicebot/testing"
+)
+
+func TestDiceBot(t *testing.T) {
+	testDataFiles := dicebottesting.JoinWithTestData([]string{
+		"Cthulhu.txt",
+	})
+
+	dicebottesting.Run("Cthulhu", t, testDataFiles...)
+}
+
+func TestDiceBot_English(t *testing.T) {
+	testDataFiles := dicebottesting.JoinWithTestData([]string{
+		"Cthulhu_en.txt",
+	})
+
+	dicebottesting.RunWithSetup(
+		"Cthulhu", t, dicebottesting.SetLocale(locale.EN), testDataFiles...)
+}
+
+func TestCthulhu_GameID(t *testing.T) {
+	expected := "Cthulhu"
+	actual := cthulhu.New().GameID()
+
+	if actual != expected {
+		t.Fatalf("got: %q, want: %q", actual, expected)
+	}
+}
+
+func TestCthulhu_Usage(t *testing.T) {
+	if len(cthulhu.New().Usage()) <= 0 {
+		t.Fatal("Usage() が空文字列")
+	}
+}
+
+func TestCthulhu_SuccessCheckResult(t *testing.T) {
+	testcases := []struct {
+		input    string
+		dice     []dice.Die
+		expected command.SuccessCheckResultType
+	}{
+		{"CC<=50", []dice.Die{{1, 100}}, command.SUCCESS_CHECK_CRITICAL},
+		{"CC<=50", []dice.Die{{10, 100}}, command.SUCCESS_CHECK_SPECIAL},
+		{"CC<=50", []dice.Die{{35, 100}}, command.SUCCESS_CHECK_SUCCESS},
+		{"CC<=50", []dice.Die{{51, 100}}, command.SUCCESS_CHECK_FAILURE},
+		{"CC<=50", []dice.Die{{100, 100}}, command.SUCCESS_CHECK_FUMBLE},
+		{"CCB<=50", []dice.Die{{96, 100}}, command.SUCCESS_CHECK_FUMBLE},
+		{"RES(20-1)", nil, command.SUCCESS_CHECK_AUTO_SUCCESS},
+		{"RES(1-20)", nil, command.SUCCESS_CHECK_AUTO_FAILURE},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.input, func(t *testing.T) {
+			b := bcdice.New(feeder.NewQueue(test.dice))
+			if err := b.SetDiceBotByGameID("Cthulhu"); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := b.ExecuteCommand(test.input)
+			if err != nil {
+				t.Fatalf("コマンド実行エラー: %s", err)
+			}
+
+			if result.SuccessCheckResult != test.expected {
+				t.Errorf("got: %s, want: %s", result.SuccessCheckResult, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package cthulhu
+
+import (
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+)
+
+// クトゥルフ神話TRPGのダイスボットのメッセージID
+const (
+	// 決定的成功
+	msgCritical locale.MessageID = "cthulhu.critical"
+	// 決定的成功かつスペシャル
+	msgCriticalSpecial locale.MessageID = "cthulhu.critical_special"
+	// スペシャル
+	msgSpecial locale.MessageID = "cthulhu.special"
+	// 致命的失敗
+	msgFumble locale.MessageID = "cthulhu.fumble"
+	// 部分的成功
+	msgPartialSuccess locale.MessageID = "cthulhu.partial_success"
+	// 自動成功
+	msgAutoSuccess locale.MessageID = "cthulhu.auto_success"
+	// 自動失敗
+	msgAutoFailure locale.MessageID = "cthulhu.auto_failure"
+	// 故障
+	msgBroken locale.MessageID = "cthulhu.broken"
+	// 故障ナンバー（引数：故障ナンバー）
+	msgBrokenNumber locale.MessageID = "cthulhu.broken_number"
+)
+
+func init() {
+	locale.Register(locale.JA, locale.Messages{
+		msgCritical:        "決定的成功",
+		msgCriticalSpecial: "決定的成功/スペシャル",
+		msgSpecial:         "スペシャル",
+		msgFumble:          "致命的失敗",
+		msgPartialSuccess:  "部分的成功",
+		msgAutoSuccess:     "自動成功",
+		msgAutoFailure:     "自動失敗",
+		msgBroken:          "故障",
+		msgBrokenNumber:    "故障ナンバー[%d]",
+	})
+
+	locale.Register(locale.EN, locale.Messages{
+		msgCritical:        "Critical",
+		msgCriticalSpecial: "Critical/Special",
+		msgSpecial:         "Special",
+		msgFumble:          "Fumble",
+		msgPartialSuccess:  "Partial success",
+		msgAutoSuccess:     "Automatic success",
+		msgAutoFailure:     "Automatic failure",
+		msgBroken:          "Malfunction",
+		msgBrokenNumber:    "Malfunction number[%d]",
+	})
+}
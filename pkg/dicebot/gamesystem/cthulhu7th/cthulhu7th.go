@@ -0,0 +1,272 @@
+/*
+新クトゥルフ神話TRPG（第7版）のダイスボットのパッケージ。
+*/
+package cthulhu7th
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+	"github.com/raa0121/GoBCDice/pkg/dicebot"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/list"
+)
+
+// basicInfo はダイスボットの基本情報。
+var basicInfo = dicebot.DiceBotBasicInfo{
+	GameID:   "Cthulhu7th",
+	GameName: "新クトゥルフ神話TRPG",
+	Usage: `・判定　CC(x)<=（目標値）
+　x：ボーナス・ペナルティダイス (2～－2)。省略可。
+　目標値が無くても1D100は表示される。
+　ファンブル／失敗／　レギュラー成功／ハード成功／
+　イクストリーム成功／クリティカル を自動判定。
+　例）CC<=30　CC(2)<=50　CC(-1)<=75　CC-1<=50　CC1<=65　CC
+・組み合わせ判定　(CBR(x,y))
+　目標値 x と y で％ロールを行い、成否を判定。
+　例）CBR(50,20)`,
+	SortKey: "しんくとうるふしんわTRPG",
+	Prefixes: []string{
+		`CC(\([+\-]?\d+\)|[+\-]?\d+)?(<=\d+)?`,
+		`CBR\(\d+,\d+\)`,
+	},
+}
+
+func init() {
+	list.MustRegister(New, "CoC7th")
+}
+
+// BasicInfo はダイスボットの基本情報を返す。
+func BasicInfo() *dicebot.DiceBotBasicInfo {
+	return &basicInfo
+}
+
+// 新クトゥルフ神話TRPGのダイスボット。
+type Cthulhu7th struct {
+	dicebot.DiceBotImpl
+}
+
+// Cthulhu7th がDiceBotを実装していることの確認。
+var _ dicebot.DiceBot = (*Cthulhu7th)(nil)
+
+// New は新しいダイスボットを構築する。
+func New() dicebot.DiceBot {
+	return &Cthulhu7th{
+		DiceBotImpl: dicebot.DiceBotImpl{
+			BasicInfo: BasicInfo(),
+		},
+	}
+}
+
+// ボーナス・ペナルティダイスの数の最大値
+const maxBonusDice = 2
+
+var (
+	// 判定のコマンドを表す正規表現
+	checkCommandRe = regexp.MustCompile(
+		`\A(?i:CC(?:\(([+\-]?\d+)\)|([+\-]?\d+))?(?:<=(\d+))?)\z`)
+	// 組み合わせ判定のコマンドを表す正規表現
+	combinedCommandRe = regexp.MustCompile(`\A(?i:CBR\((\d+),(\d+)\))\z`)
+)
+
+// ExecuteCommand は指定されたコマンドを実行する。
+func (c *Cthulhu7th) ExecuteCommand(
+	input string,
+	ev *evaluator.Evaluator,
+) (*command.Result, error) {
+	if m := checkCommandRe.FindStringSubmatch(input); m != nil {
+		return c.executeCheck(m, ev)
+	}
+
+	if m := combinedCommandRe.FindStringSubmatch(input); m != nil {
+		return c.executeCombinedRoll(m, ev)
+	}
+
+	return nil, fmt.Errorf("%s: %w: %s", c.GameID(), dicebot.ErrUnknownCommand, input)
+}
+
+// executeCheck は判定を行う。
+//
+// ボーナス・ペナルティダイスの数だけ十の位のダイスを追加で振り、
+// ボーナスダイスの場合は最も小さい値、ペナルティダイスの場合は最も大きい値を採用する。
+func (c *Cthulhu7th) executeCheck(m []string, ev *evaluator.Evaluator) (*command.Result, error) {
+	bonusDiceStr := m[1]
+	if bonusDiceStr == "" {
+		bonusDiceStr = m[2]
+	}
+
+	bonusDice := 0
+	if bonusDiceStr != "" {
+		bonusDice, _ = strconv.Atoi(bonusDiceStr)
+	}
+
+	if bonusDice < -maxBonusDice || bonusDice > maxBonusDice {
+		return nil, fmt.Errorf("%s: bonus/penalty dice out of range: %d", c.GameID(), bonusDice)
+	}
+
+	totals, total, err := rollWithBonusDice(bonusDice, ev)
+	if err != nil {
+		return nil, err
+	}
+
+	totalTexts := make([]string, 0, len(totals))
+	for _, t := range totals {
+		totalTexts = append(totalTexts, strconv.Itoa(t))
+	}
+
+	result := &command.Result{
+		GameID:     c.GameID(),
+		RolledDice: ev.RolledDice(),
+	}
+
+	if m[3] == "" {
+		// 目標値なし：出目のみを表示する
+		result.AppendMessagePart("(1D100) " + ev.Message(msgBonusDice, bonusDice))
+		result.AppendMessagePart(strings.Join(totalTexts, ", "))
+		result.AppendMessagePart(strconv.Itoa(total))
+
+		return result, nil
+	}
+
+	target, _ := strconv.Atoi(m[3])
+
+	var textID locale.MessageID
+	result.SuccessCheckResult, textID = checkResult(total, target)
+
+	result.AppendMessagePart(fmt.Sprintf("(1D100<=%d) ", target) + ev.Message(msgBonusDice, bonusDice))
+	result.AppendMessagePart(strings.Join(totalTexts, ", "))
+	result.AppendMessagePart(strconv.Itoa(total))
+	result.AppendMessagePart(ev.Message(textID))
+
+	return result, nil
+}
+
+// executeCombinedRoll は組み合わせ判定を行う。
+//
+// 1回の出目で2つの目標値に対する判定を行い、
+// 両方成功すれば「成功」、一方のみ成功すれば「部分的成功」、両方失敗すれば「失敗」となる。
+func (c *Cthulhu7th) executeCombinedRoll(m []string, ev *evaluator.Evaluator) (*command.Result, error) {
+	target1, _ := strconv.Atoi(m[1])
+	target2, _ := strconv.Atoi(m[2])
+
+	rolledDice, err := ev.RollDice(1, 100)
+	if err != nil {
+		return nil, err
+	}
+	total := rolledDice[0].Value
+
+	result1, textID1 := checkResult(total, target1)
+	result2, textID2 := checkResult(total, target2)
+	success1 := result1.IsSuccess()
+	success2 := result2.IsSuccess()
+
+	result := &command.Result{
+		GameID:     c.GameID(),
+		RolledDice: ev.RolledDice(),
+	}
+
+	var rank locale.MessageID
+	switch {
+	case success1 && success2:
+		rank = locale.SUCCESS
+		result.SuccessCheckResult = command.SUCCESS_CHECK_SUCCESS
+	case success1 || success2:
+		rank = msgPartialSuccess
+		result.SuccessCheckResult = command.SUCCESS_CHECK_SUCCESS
+	default:
+		rank = locale.FAILURE
+		result.SuccessCheckResult = command.SUCCESS_CHECK_FAILURE
+	}
+
+	result.AppendMessagePart(fmt.Sprintf("(1d100<=%d,%d)", target1, target2))
+	result.AppendMessagePart(fmt.Sprintf("%d[%s,%s]",
+		total, ev.Message(textID1), ev.Message(textID2)))
+	result.AppendMessagePart(ev.Message(rank))
+
+	return result, nil
+}
+
+// rollWithBonusDice は、ボーナス・ペナルティダイスを含めて1D100を振る。
+//
+// 一の位のダイスを1個、十の位のダイスを1+|bonusDice|個振る。
+// 返り値は、十の位のダイスごとの出目のスライス、採用した出目、エラー。
+func rollWithBonusDice(bonusDice int, ev *evaluator.Evaluator) ([]int, int, error) {
+	unitsDigit, err := rollD10Digit(ev)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	numOfTensDice := 1 + abs(bonusDice)
+	totals := make([]int, 0, numOfTensDice)
+	for i := 0; i < numOfTensDice; i++ {
+		tensDigit, err := rollD10Digit(ev)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		t := tensDigit*10 + unitsDigit
+		if t == 0 {
+			t = 100
+		}
+
+		totals = append(totals, t)
+	}
+
+	total := totals[0]
+	for _, t := range totals[1:] {
+		if (bonusDice > 0 && t < total) || (bonusDice < 0 && t > total) {
+			total = t
+		}
+	}
+
+	return totals, total, nil
+}
+
+// rollD10Digit は1D10を振り、0〜9の数字として返す（出目10は0とする）。
+func rollD10Digit(ev *evaluator.Evaluator) (int, error) {
+	rolledDice, err := ev.RollDice(1, 10)
+	if err != nil {
+		return 0, err
+	}
+
+	return rolledDice[0].Value % 10, nil
+}
+
+// checkResult は、出目totalと目標値targetから判定結果を求める。
+// 返り値は成功判定結果と、判定結果のメッセージID。
+// イクストリーム成功はスペシャルとして扱う。
+//
+// 目標値が50未満の場合は96以上、50以上の場合は100がファンブルとなる。
+func checkResult(total int, target int) (command.SuccessCheckResultType, locale.MessageID) {
+	if total <= target {
+		switch {
+		case total == 1:
+			return command.SUCCESS_CHECK_CRITICAL, msgCritical
+		case total <= target/5:
+			return command.SUCCESS_CHECK_SPECIAL, msgExtremeSuccess
+		case total <= target/2:
+			return command.SUCCESS_CHECK_SUCCESS, msgHardSuccess
+		default:
+			return command.SUCCESS_CHECK_SUCCESS, msgRegularSuccess
+		}
+	}
+
+	if total == 100 || (total >= 96 && target < 50) {
+		return command.SUCCESS_CHECK_FUMBLE, msgFumble
+	}
+
+	return command.SUCCESS_CHECK_FAILURE, locale.FAILURE
+}
+
+// abs は整数の絶対値を返す。
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
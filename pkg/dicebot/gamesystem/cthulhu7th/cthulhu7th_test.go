@@ -0,0 +1,41 @@
+package cthulhu7th_test
+
+import (
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/cthulhu7th"
+	dicebottesting "github.com/raa0121/GoBCDice/pkg/dicebot/testing"
+)
+
+func TestDiceBot(t *testing.T) {
+	testDataFiles := dicebottesting.JoinWithTestData([]string{
+		"Cthulhu7th.txt",
+	})
+
+	dicebottesting.Run("Cthulhu7th", t, testDataFiles...)
+}
+
+func TestDiceBot_English(t *testing.T) {
+	testDataFiles := dicebottesting.JoinWithTestData([]string{
+		"Cthulhu7th_en.txt",
+	})
+
+	dicebottesting.RunWithSetup(
+		"Cthulhu7th", t, dicebottesting.SetLocale(locale.EN), testDataFiles...)
+}
+
+func TestCthulhu7th_GameID(t *testing.T) {
+	expected := "Cthulhu7th"
+	actual := cthulhu7th.New().GameID()
+
+	if actual != expected {
+		t.Fatalf("got: %q, want: %q", actual, expected)
+	}
+}
+
+func TestCthulhu7th_Usage(t *testing.T) {
+	if len(cthulhu7th.New().Usage()) <= 0 {
+		t.Fatal("Usage() が空文字列")
+	}
+}
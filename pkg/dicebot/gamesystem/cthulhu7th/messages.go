@@ -0,0 +1,45 @@
+package cthulhu7th
+
+import (
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+)
+
+// 新クトゥルフ神話TRPGのダイスボットのメッセージID
+const (
+	// クリティカル
+	msgCritical locale.MessageID = "cthulhu7th.critical"
+	// イクストリーム成功
+	msgExtremeSuccess locale.MessageID = "cthulhu7th.extreme_success"
+	// ハード成功
+	msgHardSuccess locale.MessageID = "cthulhu7th.hard_success"
+	// レギュラー成功
+	msgRegularSuccess locale.MessageID = "cthulhu7th.regular_success"
+	// ファンブル
+	msgFumble locale.MessageID = "cthulhu7th.fumble"
+	// 部分的成功
+	msgPartialSuccess locale.MessageID = "cthulhu7th.partial_success"
+	// ボーナス・ペナルティダイスの数（引数：ダイスの数）
+	msgBonusDice locale.MessageID = "cthulhu7th.bonus_dice"
+)
+
+func init() {
+	locale.Register(locale.JA, locale.Messages{
+		msgCritical:       "クリティカル",
+		msgExtremeSuccess: "イクストリーム成功",
+		msgHardSuccess:    "ハード成功",
+		msgRegularSuccess: "レギュラー成功",
+		msgFumble:         "ファンブル",
+		msgPartialSuccess: "部分的成功",
+		msgBonusDice:      "ボーナス・ペナルティダイス[%d]",
+	})
+
+	locale.Register(locale.EN, locale.Messages{
+		msgCritical:       "Critical",
+		msgExtremeSuccess: "Extreme success",
+		msgHardSuccess:    "Hard success",
+		msgRegularSuccess: "Regular success",
+		msgFumble:         "Fumble",
+		msgPartialSuccess: "Partial success",
+		msgBonusDice:      "Bonus/penalty dice[%d]",
+	})
+}
@@ -0,0 +1,50 @@
+package swordworld
+
+import (
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+)
+
+// AdjustSuccessCheckResult は、加算ロール式の成功判定の結果を調整する。
+//
+// 2D6による判定の場合、1ゾロは自動的失敗、6ゾロは自動的成功とする。
+// バラバラロールなど、加算ロール式の成功判定以外の結果は調整しない。
+func (s *SwordWorld) AdjustSuccessCheckResult(result *command.Result, ev *evaluator.Evaluator) {
+	if !result.Detail.IsSumRollComparison() {
+		return
+	}
+
+	if !result.SuccessCheckResult.IsSuccess() && !result.SuccessCheckResult.IsFailure() {
+		return
+	}
+
+	if !is2D6(result.RolledDice) || len(result.MessageParts) == 0 {
+		return
+	}
+
+	lastPart := len(result.MessageParts) - 1
+	switch result.RolledDice[0].Value + result.RolledDice[1].Value {
+	case 12:
+		result.SuccessCheckResult = command.SUCCESS_CHECK_AUTO_SUCCESS
+		result.MessageParts[lastPart] = ev.Message(msgAutoSuccess)
+	case 2:
+		result.SuccessCheckResult = command.SUCCESS_CHECK_AUTO_FAILURE
+		result.MessageParts[lastPart] = ev.Message(msgAutoFailure)
+	}
+}
+
+// is2D6 は、振られたダイスが2個の6面ダイスであるかを返す。
+func is2D6(rolledDice []dice.Die) bool {
+	if len(rolledDice) != 2 {
+		return false
+	}
+
+	for _, d := range rolledDice {
+		if d.Sides != 6 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,89 @@
+package swordworld
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+)
+
+const (
+	// 成長ロールの最大回数
+	maxGrowthCount = 100
+)
+
+// 成長ロールのコマンドを表す正規表現
+var growthCommandRe = regexp.MustCompile(`\A(?i:GR)(\d+)?\z`)
+
+// 1D6の出目と能力値の名前のメッセージIDとの対応
+var abilityNames = []locale.MessageID{
+	msgDexterity,
+	msgAgility,
+	msgStrength,
+	msgVitality,
+	msgIntelligence,
+	msgSpirit,
+}
+
+// parseGrowthCommand は成長ロールのコマンドを解析し、成長の回数を返す。
+// 2番目の返り値は、cが成長ロールのコマンドであったかどうか。
+func parseGrowthCommand(c string) (int, bool) {
+	m := growthCommandRe.FindStringSubmatch(c)
+	if m == nil {
+		return 0, false
+	}
+
+	if m[1] == "" {
+		return 1, true
+	}
+
+	count, err := strconv.Atoi(m[1])
+	if err != nil || count < 1 || count > maxGrowthCount {
+		return 0, false
+	}
+
+	return count, true
+}
+
+// executeGrowth は成長ロールをcount回行う。
+//
+// 結果のメッセージは、1回ごとに "[3,5]->(筋力 or 知力)" という形式となり、
+// 複数回の場合は " | " で区切られる。
+func executeGrowth(
+	count int,
+	gameID string,
+	ev *evaluator.Evaluator,
+) (*command.Result, error) {
+	steps := make([]string, 0, count)
+
+	for i := 0; i < count; i++ {
+		rolledDice, err := ev.RollDice(2, 6)
+		if err != nil {
+			return nil, err
+		}
+
+		d1 := rolledDice[0].Value
+		d2 := rolledDice[1].Value
+		a1 := ev.Message(abilityNames[d1-1])
+		a2 := ev.Message(abilityNames[d2-1])
+
+		if a1 == a2 {
+			steps = append(steps, fmt.Sprintf("[%d,%d]->(%s)", d1, d2, a1))
+		} else {
+			steps = append(steps, fmt.Sprintf("[%d,%d]->(%s or %s)", d1, d2, a1, a2))
+		}
+	}
+
+	result := &command.Result{
+		GameID:     gameID,
+		RolledDice: ev.RolledDice(),
+	}
+
+	result.AppendMessagePart(strings.Join(steps, " | "))
+
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package swordworld
+
+import (
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+)
+
+// ソード・ワールドのダイスボットのメッセージID
+const (
+	// 自動的成功
+	msgAutoSuccess locale.MessageID = "swordworld.auto_success"
+	// 自動的失敗
+	msgAutoFailure locale.MessageID = "swordworld.auto_failure"
+	// クリティカルの回数（引数：回数）
+	msgRounds locale.MessageID = "swordworld.rounds"
+	// 半減
+	msgHalved locale.MessageID = "swordworld.halved"
+	// 能力値の名前：器用度
+	msgDexterity locale.MessageID = "swordworld.dexterity"
+	// 能力値の名前：敏捷度
+	msgAgility locale.MessageID = "swordworld.agility"
+	// 能力値の名前：筋力
+	msgStrength locale.MessageID = "swordworld.strength"
+	// 能力値の名前：生命力
+	msgVitality locale.MessageID = "swordworld.vitality"
+	// 能力値の名前：知力
+	msgIntelligence locale.MessageID = "swordworld.intelligence"
+	// 能力値の名前：精神力
+	msgSpirit locale.MessageID = "swordworld.spirit"
+)
+
+func init() {
+	locale.Register(locale.JA, locale.Messages{
+		msgAutoSuccess:  "自動的成功",
+		msgAutoFailure:  "自動的失敗",
+		msgRounds:       "%d回転",
+		msgHalved:       "半減",
+		msgDexterity:    "器用度",
+		msgAgility:      "敏捷度",
+		msgStrength:     "筋力",
+		msgVitality:     "生命力",
+		msgIntelligence: "知力",
+		msgSpirit:       "精神力",
+	})
+
+	locale.Register(locale.EN, locale.Messages{
+		msgAutoSuccess:  "Automatic success",
+		msgAutoFailure:  "Automatic failure",
+		msgRounds:       "Critical x%d",
+		msgHalved:       "Halved",
+		msgDexterity:    "Dexterity",
+		msgAgility:      "Agility",
+		msgStrength:     "Strength",
+		msgVitality:     "Vitality",
+		msgIntelligence: "Intelligence",
+		msgSpirit:       "Spirit",
+	})
+}
@@ -0,0 +1,233 @@
+package swordworld
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+)
+
+const (
+	// 既定のクリティカル値
+	defaultCritical = 10
+	// クリティカル値の最小値（これより小さい値は最小値に切り上げる）
+	minCritical = 3
+	// 2Dの出目の最大値
+	maxDiceValue = 12
+	// 自動的失敗となる2Dの出目
+	autoFailureDiceValue = 2
+)
+
+var (
+	// レーティング表のコマンドを表す正規表現
+	ratingCommandRe = regexp.MustCompile(
+		`\A(?i:(H)?K(\d+)((?:[+\-]\d+|@\d+|\[\d+\]|\$[+\-]?\d+)*)(H)?)\z`)
+	// レーティング表のコマンドの修飾部分を表す正規表現
+	ratingModifierRe = regexp.MustCompile(`[+\-]\d+|@\d+|\[\d+\]|\$[+\-]?\d+`)
+)
+
+// ratingCommand はレーティング表のコマンドを表す構造体。
+type ratingCommand struct {
+	// キーナンバー
+	key int
+	// クリティカル値
+	critical int
+	// ボーナス（最終結果への修正値）
+	bonus int
+	// 最初の出目を差し替える値（0の場合は差し替えない）
+	firstDiceValue int
+	// 最初の出目への修正値
+	firstDiceModifier int
+	// 出目の修正が指定されたかどうか
+	diceModified bool
+	// 最終結果を半減するかどうか
+	halved bool
+}
+
+// parseRatingCommand はレーティング表のコマンドを解析する。
+// 2番目の返り値は、cがレーティング表のコマンドであったかどうか。
+func (s *SwordWorld) parseRatingCommand(c string) (*ratingCommand, bool) {
+	m := ratingCommandRe.FindStringSubmatch(c)
+	if m == nil {
+		return nil, false
+	}
+
+	halved := m[1] != "" || m[4] != ""
+	if halved && s.Edition < EDITION_2_0 {
+		return nil, false
+	}
+
+	key, _ := strconv.Atoi(m[2])
+	if key >= len(ratingTable) {
+		return nil, false
+	}
+
+	r := &ratingCommand{
+		key:      key,
+		critical: defaultCritical,
+		halved:   halved,
+	}
+
+	for _, mod := range ratingModifierRe.FindAllString(m[3], -1) {
+		switch mod[0] {
+		case '@':
+			r.critical, _ = strconv.Atoi(mod[1:])
+		case '[':
+			r.critical, _ = strconv.Atoi(mod[1 : len(mod)-1])
+		case '$':
+			r.diceModified = true
+
+			value, _ := strconv.Atoi(mod[1:])
+			if mod[1] == '+' || mod[1] == '-' {
+				r.firstDiceValue = 0
+				r.firstDiceModifier = value
+			} else {
+				r.firstDiceValue = value
+				r.firstDiceModifier = 0
+			}
+		default:
+			value, _ := strconv.Atoi(mod)
+			r.bonus += value
+		}
+	}
+
+	if r.critical < minCritical {
+		r.critical = minCritical
+	}
+
+	return r, true
+}
+
+// notation はコマンドの表記を返す。
+func (r *ratingCommand) notation() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "KeyNo.%dc[%d]", r.key, r.critical)
+
+	if r.diceModified {
+		if r.firstDiceValue != 0 {
+			fmt.Fprintf(&b, "m[%d]", r.firstDiceValue)
+		} else {
+			fmt.Fprintf(&b, "m[%+d]", r.firstDiceModifier)
+		}
+	}
+
+	b.WriteString(formatBonus(r.bonus))
+
+	return b.String()
+}
+
+// execute はレーティング表を振る。
+func (r *ratingCommand) execute(
+	gameID string,
+	ev *evaluator.Evaluator,
+) (*command.Result, error) {
+	rolledDiceTexts := []string{}
+	diceValueTexts := []string{}
+	rateTexts := []string{}
+
+	total := 0
+	rounds := 0
+	autoFailure := false
+
+	for {
+		rolledDice, err := ev.RollDice(2, 6)
+		if err != nil {
+			return nil, err
+		}
+
+		diceValue := rolledDice[0].Value + rolledDice[1].Value
+		rolledDiceTexts = append(rolledDiceTexts,
+			fmt.Sprintf("%d,%d", rolledDice[0].Value, rolledDice[1].Value))
+
+		// 出目の修正は最初の1回だけ適用する
+		if rounds == 0 {
+			if r.firstDiceValue != 0 {
+				diceValue = r.firstDiceValue
+			} else {
+				diceValue += r.firstDiceModifier
+			}
+		}
+
+		if diceValue > maxDiceValue {
+			diceValue = maxDiceValue
+		}
+
+		diceValueTexts = append(diceValueTexts, strconv.Itoa(diceValue))
+
+		if diceValue <= autoFailureDiceValue {
+			rateTexts = append(rateTexts, "**")
+			autoFailure = rounds == 0
+			break
+		}
+
+		rate := ratingTable[r.key][diceValue-(autoFailureDiceValue+1)]
+		rateTexts = append(rateTexts, strconv.Itoa(rate))
+		total += rate
+
+		if diceValue < r.critical {
+			break
+		}
+
+		rounds++
+	}
+
+	result := &command.Result{
+		GameID:     gameID,
+		RolledDice: ev.RolledDice(),
+	}
+
+	result.AppendMessagePart(r.notation())
+	result.AppendMessagePart(fmt.Sprintf("2D:[%s]=%s",
+		strings.Join(rolledDiceTexts, " "),
+		strings.Join(diceValueTexts, ",")))
+
+	if autoFailure {
+		result.SuccessCheckResult = command.SUCCESS_CHECK_AUTO_FAILURE
+		result.AppendMessagePart("**")
+		result.AppendMessagePart(ev.Message(msgAutoFailure))
+
+		return result, nil
+	}
+
+	result.AppendMessagePart(strings.Join(rateTexts, ",") + formatBonus(r.bonus))
+
+	if rounds > 0 {
+		result.AppendMessagePart(ev.Message(msgRounds, rounds))
+	}
+
+	total += r.bonus
+
+	if r.bonus != 0 || rounds > 0 {
+		result.AppendMessagePart(strconv.Itoa(total))
+	}
+
+	if r.halved {
+		result.AppendMessagePart(ev.Message(msgHalved))
+		result.AppendMessagePart(strconv.Itoa(halve(total)))
+	}
+
+	return result, nil
+}
+
+// formatBonus はボーナスの表記を返す。
+// ボーナスが0の場合は空文字列を返す。
+func formatBonus(bonus int) string {
+	if bonus == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%+d", bonus)
+}
+
+// halve は値を半減する（端数切り上げ）。
+func halve(value int) int {
+	if value < 0 {
+		return -((-value) / 2)
+	}
+
+	return (value + 1) / 2
+}
@@ -0,0 +1,109 @@
+package swordworld
+
+// レーティング表（威力表）。
+//
+// 行がキーナンバー（威力）0〜100、列が2Dの出目3〜12に対応する。
+// 出目2（1ゾロ）は自動的失敗となるため、表には含まれない。
+var ratingTable = [][10]int{
+	/*   0 */ {0, 0, 0, 1, 2, 2, 3, 3, 4, 4},
+	/*   1 */ {0, 0, 0, 1, 2, 3, 3, 3, 4, 4},
+	/*   2 */ {0, 0, 0, 1, 2, 3, 4, 4, 4, 4},
+	/*   3 */ {0, 0, 1, 1, 2, 3, 4, 4, 4, 5},
+	/*   4 */ {0, 0, 1, 2, 2, 3, 4, 4, 5, 5},
+	/*   5 */ {0, 1, 1, 2, 2, 3, 4, 5, 5, 5},
+	/*   6 */ {0, 1, 1, 2, 3, 3, 4, 5, 5, 5},
+	/*   7 */ {0, 1, 1, 2, 3, 4, 4, 5, 5, 6},
+	/*   8 */ {0, 1, 2, 2, 3, 4, 4, 5, 6, 6},
+	/*   9 */ {0, 1, 2, 3, 3, 4, 4, 5, 6, 7},
+	/*  10 */ {1, 1, 2, 3, 3, 4, 5, 5, 6, 7},
+	/*  11 */ {1, 2, 2, 3, 3, 4, 5, 6, 6, 7},
+	/*  12 */ {1, 2, 2, 3, 4, 4, 5, 6, 6, 7},
+	/*  13 */ {1, 2, 3, 3, 4, 4, 5, 6, 7, 7},
+	/*  14 */ {1, 2, 3, 4, 4, 4, 5, 6, 7, 8},
+	/*  15 */ {1, 2, 3, 4, 4, 5, 5, 6, 7, 8},
+	/*  16 */ {1, 2, 3, 4, 4, 5, 6, 7, 7, 8},
+	/*  17 */ {1, 2, 3, 4, 5, 5, 6, 7, 7, 8},
+	/*  18 */ {1, 2, 3, 4, 5, 6, 6, 7, 7, 8},
+	/*  19 */ {1, 2, 3, 4, 5, 6, 7, 7, 8, 9},
+	/*  20 */ {1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+	/*  21 */ {1, 2, 3, 4, 6, 6, 7, 8, 9, 10},
+	/*  22 */ {1, 2, 3, 5, 6, 6, 7, 8, 9, 10},
+	/*  23 */ {2, 2, 3, 5, 6, 7, 7, 8, 9, 10},
+	/*  24 */ {2, 3, 4, 5, 6, 7, 7, 8, 9, 10},
+	/*  25 */ {2, 3, 4, 5, 6, 7, 8, 8, 9, 10},
+	/*  26 */ {2, 3, 4, 5, 6, 8, 8, 9, 9, 10},
+	/*  27 */ {2, 3, 4, 6, 6, 8, 8, 9, 9, 10},
+	/*  28 */ {2, 3, 4, 6, 6, 8, 9, 9, 10, 10},
+	/*  29 */ {2, 3, 4, 6, 7, 8, 9, 9, 10, 10},
+	/*  30 */ {2, 4, 4, 6, 7, 8, 9, 10, 10, 10},
+	/*  31 */ {2, 4, 5, 6, 7, 8, 9, 10, 10, 11},
+	/*  32 */ {3, 4, 5, 6, 7, 8, 10, 10, 10, 11},
+	/*  33 */ {3, 4, 5, 6, 8, 8, 10, 10, 10, 11},
+	/*  34 */ {3, 4, 5, 6, 8, 9, 10, 10, 11, 11},
+	/*  35 */ {3, 4, 5, 7, 8, 9, 10, 10, 11, 12},
+	/*  36 */ {3, 5, 5, 7, 8, 9, 10, 11, 11, 12},
+	/*  37 */ {3, 5, 6, 7, 8, 9, 10, 11, 12, 12},
+	/*  38 */ {3, 5, 6, 7, 8, 10, 10, 11, 12, 13},
+	/*  39 */ {4, 5, 6, 7, 8, 10, 11, 11, 12, 13},
+	/*  40 */ {4, 5, 6, 7, 9, 10, 11, 11, 12, 13},
+	/*  41 */ {4, 6, 6, 7, 9, 10, 11, 12, 12, 13},
+	/*  42 */ {4, 6, 7, 7, 9, 10, 11, 12, 13, 13},
+	/*  43 */ {4, 6, 7, 8, 9, 10, 11, 12, 13, 14},
+	/*  44 */ {4, 6, 7, 8, 10, 10, 11, 12, 13, 14},
+	/*  45 */ {4, 6, 7, 9, 10, 10, 11, 12, 13, 14},
+	/*  46 */ {4, 6, 7, 9, 10, 10, 12, 13, 13, 14},
+	/*  47 */ {4, 6, 7, 9, 10, 11, 12, 13, 13, 15},
+	/*  48 */ {4, 6, 7, 9, 10, 12, 12, 13, 13, 15},
+	/*  49 */ {4, 6, 7, 10, 10, 12, 12, 13, 14, 15},
+	/*  50 */ {4, 6, 8, 10, 10, 12, 12, 13, 15, 15},
+	/*  51 */ {5, 7, 8, 10, 10, 12, 12, 13, 15, 15},
+	/*  52 */ {5, 7, 8, 10, 11, 12, 12, 13, 15, 15},
+	/*  53 */ {5, 7, 9, 10, 11, 12, 12, 14, 15, 15},
+	/*  54 */ {5, 7, 9, 10, 11, 12, 13, 14, 15, 16},
+	/*  55 */ {5, 7, 10, 10, 11, 12, 13, 14, 16, 16},
+	/*  56 */ {5, 8, 10, 10, 11, 12, 13, 15, 16, 16},
+	/*  57 */ {5, 8, 10, 11, 11, 12, 13, 15, 16, 17},
+	/*  58 */ {5, 8, 10, 11, 12, 12, 13, 15, 16, 17},
+	/*  59 */ {5, 9, 10, 11, 12, 12, 14, 15, 16, 17},
+	/*  60 */ {5, 9, 10, 11, 12, 13, 14, 15, 16, 18},
+	/*  61 */ {5, 9, 10, 11, 12, 13, 14, 16, 17, 18},
+	/*  62 */ {5, 9, 10, 11, 13, 13, 14, 16, 17, 18},
+	/*  63 */ {5, 9, 10, 11, 13, 13, 15, 17, 17, 18},
+	/*  64 */ {5, 9, 10, 11, 13, 14, 15, 17, 17, 18},
+	/*  65 */ {5, 9, 10, 12, 13, 14, 15, 17, 18, 18},
+	/*  66 */ {5, 9, 10, 12, 13, 15, 15, 17, 18, 19},
+	/*  67 */ {5, 9, 10, 12, 13, 15, 16, 17, 19, 19},
+	/*  68 */ {5, 9, 10, 12, 14, 15, 16, 17, 19, 19},
+	/*  69 */ {5, 9, 10, 12, 14, 16, 16, 17, 19, 19},
+	/*  70 */ {5, 9, 10, 12, 14, 16, 17, 18, 19, 19},
+	/*  71 */ {5, 9, 10, 13, 14, 16, 17, 18, 19, 20},
+	/*  72 */ {5, 9, 10, 13, 15, 16, 17, 18, 19, 20},
+	/*  73 */ {5, 9, 10, 13, 15, 16, 17, 19, 20, 21},
+	/*  74 */ {6, 9, 10, 13, 15, 16, 18, 19, 20, 21},
+	/*  75 */ {6, 9, 10, 13, 16, 16, 18, 19, 20, 21},
+	/*  76 */ {6, 9, 10, 13, 16, 17, 18, 19, 20, 21},
+	/*  77 */ {6, 9, 10, 13, 16, 17, 18, 20, 21, 22},
+	/*  78 */ {6, 9, 10, 13, 16, 17, 19, 20, 22, 23},
+	/*  79 */ {6, 9, 10, 13, 16, 18, 19, 20, 22, 23},
+	/*  80 */ {6, 9, 10, 13, 16, 18, 20, 21, 22, 23},
+	/*  81 */ {6, 9, 10, 13, 17, 18, 20, 21, 22, 23},
+	/*  82 */ {6, 9, 10, 14, 17, 18, 20, 21, 22, 24},
+	/*  83 */ {6, 9, 11, 14, 17, 18, 20, 21, 23, 24},
+	/*  84 */ {6, 9, 11, 14, 17, 19, 20, 21, 23, 24},
+	/*  85 */ {6, 9, 11, 14, 17, 19, 21, 22, 23, 24},
+	/*  86 */ {7, 10, 11, 14, 17, 19, 21, 22, 23, 25},
+	/*  87 */ {7, 10, 12, 14, 17, 19, 21, 22, 24, 25},
+	/*  88 */ {7, 10, 12, 14, 18, 19, 21, 22, 24, 25},
+	/*  89 */ {7, 10, 12, 15, 18, 19, 21, 22, 24, 26},
+	/*  90 */ {7, 10, 12, 15, 18, 19, 21, 23, 25, 26},
+	/*  91 */ {7, 11, 13, 15, 18, 19, 21, 23, 25, 26},
+	/*  92 */ {7, 11, 13, 15, 18, 20, 21, 23, 25, 27},
+	/*  93 */ {8, 11, 13, 15, 18, 20, 22, 23, 25, 27},
+	/*  94 */ {8, 11, 13, 16, 18, 20, 22, 23, 25, 28},
+	/*  95 */ {8, 11, 14, 16, 18, 20, 22, 23, 26, 28},
+	/*  96 */ {8, 11, 14, 16, 19, 20, 22, 23, 26, 28},
+	/*  97 */ {8, 12, 14, 16, 19, 20, 22, 24, 26, 28},
+	/*  98 */ {8, 12, 15, 16, 19, 20, 22, 24, 27, 28},
+	/*  99 */ {8, 12, 15, 17, 19, 20, 22, 24, 27, 29},
+	/* 100 */ {8, 12, 15, 18, 19, 20, 22, 24, 27, 30},
+}
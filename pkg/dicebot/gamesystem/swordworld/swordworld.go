@@ -0,0 +1,152 @@
+/*
+ソード・ワールドRPGのダイスボットのパッケージ。
+
+ソード・ワールドRPG（完全版）、ソード・ワールド2.0、ソード・ワールド2.5の
+ダイスボットを提供する。
+*/
+package swordworld
+
+import (
+	"fmt"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/dicebot"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/list"
+)
+
+// Edition はソード・ワールドの版を表す型。
+type Edition int
+
+const (
+	// 版：ソード・ワールドRPG
+	EDITION_1_0 Edition = iota
+	// 版：ソード・ワールド2.0
+	EDITION_2_0
+	// 版：ソード・ワールド2.5
+	EDITION_2_5
+)
+
+// 共通の使用法の説明
+const commonUsage = `・レーティング表　(Kx)
+　"Kキーナンバー+ボーナス"の形で記入します。
+　ボーナスの部分に「K20+K30」のようにレーティングを取ることは出来ません。
+　また、ボーナスは複数取ることが出来ます。
+　レーティング表もダイスロールと同様に、他のプレイヤーに隠れてロールすることも可能です。
+　例）K20　　　K10+5　　　k30　　　k10+10　　　Sk10-1　　　k10+5+2
+・クリティカル値の設定
+　クリティカル値は"[クリティカル値]"で指定します。
+　指定しない場合はクリティカル値10とします。
+　クリティカル処理が必要ないときは13などとしてください。(防御時などの対応)
+　またタイプの軽減化のために末尾に「@クリティカル値」でも処理するようにしました。
+　例）K20[10]　　　K10+5[9]　　　k30[10]　　　k10[9]+10　　　k10-5@9
+・ダイス目の修正（運命変転やクリティカルレイ用）
+　末尾に「$修正値」でダイス目に修正がかかります。
+　$＋１と修正表記ならダイス目に＋修正、＄９のように固定値ならダイス目をその出目に差し替え。
+　クリティカルした場合でも固定値や修正値の適用は最初の一回だけです。
+　例）K20$+1　　　K10+5$9　　　k10-5@9$+2　　　k10[9]+10$9
+・2D6による判定
+　1ゾロは自動的失敗、6ゾロは自動的成功となります。
+　例）2D6>=7　　　2D6+3>=10`
+
+// 2.0以降で追加されるコマンドの使用法の説明
+const additionalUsage = `
+・半減　(HKx)
+　レーティング表の先頭または末尾に"H"をつけると、最終結果を半減（端数切り上げ）します。
+　例）HK20　　　K20+5H　　　Hk10-1@9
+・成長　(GR)
+　末尾に数字を付加することで、複数回の成長をまとめて行えます。
+　例）GR　　　GR3`
+
+// 各版のダイスボットの基本情報
+var basicInfos = map[Edition]*dicebot.DiceBotBasicInfo{
+	EDITION_1_0: {
+		GameID:   "SwordWorld",
+		GameName: "ソードワールド",
+		Usage:    "・SW　レーティング表　(Kx[c]+m$f) (x:キー, c:クリティカル値, m:ボーナス, f:出目修正)\n" + commonUsage,
+		SortKey:  "そおとわあると",
+		Prefixes: []string{`K\d+.*`},
+	},
+	EDITION_2_0: {
+		GameID:   "SwordWorld2.0",
+		GameName: "ソードワールド2.0",
+		Usage:    "・SW2.0　レーティング表　(Kx[c]+m$f) (x:キー, c:クリティカル値, m:ボーナス, f:出目修正)\n" + commonUsage + additionalUsage,
+		SortKey:  "そおとわあると2.0",
+		Prefixes: []string{`H?K\d+.*`, `GR(\d+)?`},
+	},
+	EDITION_2_5: {
+		GameID:   "SwordWorld2.5",
+		GameName: "ソードワールド2.5",
+		Usage:    "・SW2.5　レーティング表　(Kx[c]+m$f) (x:キー, c:クリティカル値, m:ボーナス, f:出目修正)\n" + commonUsage + additionalUsage,
+		SortKey:  "そおとわあると2.5",
+		Prefixes: []string{`H?K\d+.*`, `GR(\d+)?`},
+	},
+}
+
+func init() {
+	list.MustRegister(New, "SW")
+	list.MustRegister(New2_0, "SW2.0", "SW20")
+	list.MustRegister(New2_5, "SW2.5", "SW25")
+}
+
+// BasicInfo は指定された版のダイスボットの基本情報を返す。
+func BasicInfo(edition Edition) *dicebot.DiceBotBasicInfo {
+	return basicInfos[edition]
+}
+
+// ソード・ワールドのダイスボット。
+type SwordWorld struct {
+	dicebot.DiceBotImpl
+
+	// 版
+	Edition Edition
+}
+
+// SwordWorld がDiceBotを実装していることの確認。
+var _ dicebot.DiceBot = (*SwordWorld)(nil)
+
+// New はソード・ワールドRPGのダイスボットを構築する。
+func New() dicebot.DiceBot {
+	return newSwordWorld(EDITION_1_0)
+}
+
+// New2_0 はソード・ワールド2.0のダイスボットを構築する。
+func New2_0() dicebot.DiceBot {
+	return newSwordWorld(EDITION_2_0)
+}
+
+// New2_5 はソード・ワールド2.5のダイスボットを構築する。
+func New2_5() dicebot.DiceBot {
+	return newSwordWorld(EDITION_2_5)
+}
+
+// newSwordWorld は指定された版のダイスボットを構築する。
+func newSwordWorld(edition Edition) *SwordWorld {
+	return &SwordWorld{
+		DiceBotImpl: dicebot.DiceBotImpl{
+			BasicInfo: BasicInfo(edition),
+		},
+		Edition: edition,
+	}
+}
+
+// ExecuteCommand は指定されたコマンドを実行する。
+//
+// レーティング表と成長ロール（2.0以降）を扱う。
+// 2D6による判定は基本コマンドとして実行され、AdjustSuccessCheckResult で結果が調整される。
+func (s *SwordWorld) ExecuteCommand(
+	c string,
+	ev *evaluator.Evaluator,
+) (*command.Result, error) {
+	if r, ok := s.parseRatingCommand(c); ok {
+		return r.execute(s.GameID(), ev)
+	}
+
+	if s.Edition >= EDITION_2_0 {
+		if count, ok := parseGrowthCommand(c); ok {
+			return executeGrowth(count, s.GameID(), ev)
+		}
+	}
+
+	return nil, fmt.Errorf("%s: %w: %s", s.GameID(), dicebot.ErrUnknownCommand, c)
+}
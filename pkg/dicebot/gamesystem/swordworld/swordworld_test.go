@@ -0,0 +1,108 @@
+package swordworld_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/bcdice"
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/swordworld"
+	dicebottesting "github.com/raa0121/GoBCDice/pkg/dicebot/testing"
+)
+
+func TestSwordWorld(t *testing.T) {
+	testDataFiles := []string{
+		filepath.Join("..", "..", "testdata", "SwordWorld.txt"),
+		filepath.Join("testdata", "SwordWorld_check.txt"),
+	}
+
+	dicebottesting.Run("SwordWorld", t, testDataFiles...)
+}
+
+func TestSwordWorld2_0(t *testing.T) {
+	testDataFiles := dicebottesting.JoinWithTestData([]string{
+		"SwordWorld2.0.txt",
+	})
+
+	dicebottesting.Run("SwordWorld2.0", t, testDataFiles...)
+}
+
+func TestSwordWorld2_0_English(t *testing.T) {
+	testDataFiles := dicebottesting.JoinWithTestData([]string{
+		"SwordWorld2.0_en.txt",
+	})
+
+	dicebottesting.RunWithSetup(
+		"SwordWorld2.0", t, dicebottesting.SetLocale(locale.EN), testDataFiles...)
+}
+
+func TestSwordWorld2_5(t *testing.T) {
+	testDataFiles := dicebottesting.JoinWithTestData([]string{
+		"SwordWorld2.5.txt",
+	})
+
+	dicebottesting.Run("SwordWorld2.5", t, testDataFiles...)
+}
+
+func TestGameID(t *testing.T) {
+	testcases := []struct {
+		constructor func() string
+		expected    string
+	}{
+		{func() string { return swordworld.New().GameID() }, "SwordWorld"},
+		{func() string { return swordworld.New2_0().GameID() }, "SwordWorld2.0"},
+		{func() string { return swordworld.New2_5().GameID() }, "SwordWorld2.5"},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.expected, func(t *testing.T) {
+			actual := test.constructor()
+			if actual != test.expected {
+				t.Fatalf("got: %q, want: %q", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestUsage(t *testing.T) {
+	if len(swordworld.New().Usage()) <= 0 {
+		t.Fatal("Usage() が空文字列")
+	}
+}
+
+func TestSuccessCheckResult(t *testing.T) {
+	testcases := []struct {
+		gameID   string
+		input    string
+		dice     []dice.Die
+		expected command.SuccessCheckResultType
+	}{
+		{"SwordWorld", "2D6>=7", []dice.Die{{3, 6}, {4, 6}}, command.SUCCESS_CHECK_SUCCESS},
+		{"SwordWorld", "2D6>=7", []dice.Die{{3, 6}, {3, 6}}, command.SUCCESS_CHECK_FAILURE},
+		{"SwordWorld", "2D6>=13", []dice.Die{{6, 6}, {6, 6}}, command.SUCCESS_CHECK_AUTO_SUCCESS},
+		{"SwordWorld", "2D6+5>=7", []dice.Die{{1, 6}, {1, 6}}, command.SUCCESS_CHECK_AUTO_FAILURE},
+		{"SwordWorld", "K20", []dice.Die{{1, 6}, {1, 6}}, command.SUCCESS_CHECK_AUTO_FAILURE},
+		{"SwordWorld2.5", "2D6>=7", []dice.Die{{6, 6}, {6, 6}}, command.SUCCESS_CHECK_AUTO_SUCCESS},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.gameID+"/"+test.input, func(t *testing.T) {
+			b := bcdice.New(feeder.NewQueue(test.dice))
+			if err := b.SetDiceBotByGameID(test.gameID); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := b.ExecuteCommand(test.input)
+			if err != nil {
+				t.Fatalf("コマンド実行エラー: %s", err)
+			}
+
+			if result.SuccessCheckResult != test.expected {
+				t.Errorf("got: %s, want: %s", result.SuccessCheckResult, test.expected)
+			}
+		})
+	}
+}
@@ -2,6 +2,13 @@
 ダイスボットの一覧を管理するパッケージ。
 
 このパッケージを使用することで、指定したゲーム名のダイスボットを取得することができるようになる。
+
+各ゲームシステムのパッケージは、init関数の中でRegisterまたはMustRegisterを呼び出して、
+ダイスボットを登録する。
+
+	func init() {
+		list.MustRegister(New, "sw")
+	}
 */
 package list
 
@@ -10,6 +17,8 @@ import (
 	"github.com/raa0121/GoBCDice/pkg/dicebot"
 	"github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/basic"
 	"sort"
+	"strings"
+	"sync"
 )
 
 type Names struct {
@@ -22,23 +31,129 @@ type SystemInfo struct {
 	SortKey string `json:"sort_key"`
 }
 
+// Register はダイスボットを登録する。
+//
+// constructor: ダイスボットのコンストラクタ,
+// aliases: ゲーム識別子の別名。
+//
+// ゲーム識別子はコンストラクタが返すダイスボットから取得する。
+// ゲーム識別子と別名は、大文字と小文字を区別せずに検索できるようになる。
+// ゲーム識別子または別名が既に登録されている場合はエラーを返す。
+func Register(constructor dicebot.DiceBotConstructor, aliases ...string) error {
+	if constructor == nil {
+		return fmt.Errorf("Register: constructor is nil")
+	}
+
+	gameID := constructor().GameID()
+	if gameID == "" {
+		return fmt.Errorf("Register: empty game ID")
+	}
+
+	keys := make([]string, 0, len(aliases)+1)
+	keys = append(keys, aliasKey(gameID))
+	for _, a := range aliases {
+		k := aliasKey(a)
+		if k == "" {
+			return fmt.Errorf("Register: %s: empty alias", gameID)
+		}
+
+		keys = append(keys, k)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, found := gameIDToDiceBotConstructor[gameID]; found {
+		return fmt.Errorf("Register: game system already registered: %s", gameID)
+	}
+
+	for i, k := range keys {
+		if k == aliasKey(basic.BasicInfo().GameID) {
+			return fmt.Errorf("Register: %s: reserved name: %s", gameID, k)
+		}
+
+		if registered, found := aliasToGameID[k]; found {
+			return fmt.Errorf("Register: %s: name already used by %s: %s",
+				gameID, registered, k)
+		}
+
+		for _, other := range keys[:i] {
+			if other == k {
+				return fmt.Errorf("Register: %s: duplicate alias: %s", gameID, k)
+			}
+		}
+	}
+
+	gameIDToDiceBotConstructor[gameID] = constructor
+	for _, k := range keys {
+		aliasToGameID[k] = gameID
+	}
+
+	return nil
+}
+
+// MustRegister はダイスボットを登録する。
+// 登録に失敗した場合はpanicに陥る。
+//
+// init関数の中での使用を想定している。
+func MustRegister(constructor dicebot.DiceBotConstructor, aliases ...string) {
+	if err := Register(constructor, aliases...); err != nil {
+		panic(err)
+	}
+}
+
+// Unregister は指定された識別子を持つゲームシステムのダイスボットの登録を解除する。
+// ゲーム識別子の別名の登録も解除される。
+//
+// テストでの使用を想定している。
+// ゲームシステムが登録されていなかった場合はエラーを返す。
+func Unregister(gameID string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, found := gameIDToDiceBotConstructor[gameID]; !found {
+		return fmt.Errorf("Unregister: unknown game system: %s", gameID)
+	}
+
+	delete(gameIDToDiceBotConstructor, gameID)
+	for k, id := range aliasToGameID {
+		if id == gameID {
+			delete(aliasToGameID, k)
+		}
+	}
+
+	return nil
+}
+
 // Find は指定された識別子を持つゲームシステムのダイスボットのコンストラクタを返す。
+// ゲーム識別子の代わりに、登録されている別名を指定することもできる。
+// 大文字と小文字は区別しない。
 // ゲームシステムが見つからなかった場合はエラーを返す。
 func Find(gameID string) (dicebot.DiceBotConstructor, error) {
-	if gameID == basic.BasicInfo().GameID {
+	if aliasKey(gameID) == aliasKey(basic.BasicInfo().GameID) {
 		return basic.New, nil
 	}
 
+	mu.RLock()
+	defer mu.RUnlock()
+
 	constructor, found := gameIDToDiceBotConstructor[gameID]
-	if !found {
-		return nil, fmt.Errorf("unknown game system: %s", gameID)
+	if found {
+		return constructor, nil
+	}
+
+	if registeredGameID, aliasFound := aliasToGameID[aliasKey(gameID)]; aliasFound {
+		return gameIDToDiceBotConstructor[registeredGameID], nil
 	}
 
-	return constructor, nil
+	return nil, fmt.Errorf("unknown game system: %s", gameID)
 }
 
 // AvailableGameIDs は利用可能なゲームシステムの識別子のスライスを返す。
 func AvailableGameIDs(includeBasicDiceBot bool) []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
 	gameIDs := make([]string, 0, len(gameIDToDiceBotConstructor))
 	for k := range gameIDToDiceBotConstructor {
 		gameIDs = append(gameIDs, k)
@@ -60,24 +175,27 @@ func AvailableGameIDs(includeBasicDiceBot bool) []string {
 
 // AvailableGameInfos は利用可能なゲームシステムの情報のスライスを返す。
 func AvailableGameInfos(includeBasicDiceBot bool) Names {
+	mu.RLock()
+	defer mu.RUnlock()
+
 	games := make([]SystemInfo, 0, len(gameIDToDiceBotConstructor))
 	for _, k := range gameIDToDiceBotConstructor {
 		info := SystemInfo{
-			System: k().GameID(),
-			Name: k().GameName(),
+			System:  k().GameID(),
+			Name:    k().GameName(),
 			SortKey: k().SortKey(),
 		}
 		games = append(games, info)
 	}
-	sort.Slice(games, func (i, j int) bool { return games[i].SortKey < games[j].SortKey })
+	sort.Slice(games, func(i, j int) bool { return games[i].SortKey < games[j].SortKey })
 
 	if !includeBasicDiceBot {
 		return Names{Name: games}
 	}
 	gamesWithBasicDiceBot := make([]SystemInfo, 1, len(games)+1)
 	info := SystemInfo{
-		System: basic.BasicInfo().GameID,
-		Name: basic.BasicInfo().GameName,
+		System:  basic.BasicInfo().GameID,
+		Name:    basic.BasicInfo().GameName,
 		SortKey: basic.BasicInfo().SortKey,
 	}
 	gamesWithBasicDiceBot[0] = info
@@ -85,5 +203,16 @@ func AvailableGameInfos(includeBasicDiceBot bool) Names {
 	return Names{Name: gamesWithBasicDiceBot}
 }
 
-// ゲーム識別子とダイスボットのコンストラクタとの対応
-var gameIDToDiceBotConstructor = map[string]dicebot.DiceBotConstructor{}
+// aliasKey はゲーム識別子または別名を検索用のキーに変換する。
+func aliasKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+var (
+	// 登録内容を保護するためのミューテックス
+	mu sync.RWMutex
+	// ゲーム識別子とダイスボットのコンストラクタとの対応
+	gameIDToDiceBotConstructor = map[string]dicebot.DiceBotConstructor{}
+	// 検索用のキー（小文字にしたゲーム識別子および別名）とゲーム識別子との対応
+	aliasToGameID = map[string]string{}
+)
@@ -0,0 +1,150 @@
+package list_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/dicebot"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/list"
+)
+
+// newTestDiceBotConstructor はテスト用のダイスボットのコンストラクタを返す。
+func newTestDiceBotConstructor(gameID string, sortKey string) dicebot.DiceBotConstructor {
+	info := &dicebot.DiceBotBasicInfo{
+		GameID:   gameID,
+		GameName: gameID + " (test)",
+		Usage:    "test",
+		SortKey:  sortKey,
+	}
+
+	return func() dicebot.DiceBot {
+		return &dicebot.DiceBotImpl{BasicInfo: info}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	err := list.Register(newTestDiceBotConstructor("TestSwordWorld", "てすとそーど"), "tsw", "TestSW")
+	if err != nil {
+		t.Fatalf("登録に失敗: %s", err)
+	}
+	defer list.Unregister("TestSwordWorld")
+
+	for _, name := range []string{"TestSwordWorld", "testswordworld", "tsw", "TSW", "testsw"} {
+		t.Run(name, func(t *testing.T) {
+			constructor, findErr := list.Find(name)
+			if findErr != nil {
+				t.Fatalf("見つからない: %s", findErr)
+			}
+
+			actual := constructor().GameID()
+			if actual != "TestSwordWorld" {
+				t.Errorf("got: %q, want: %q", actual, "TestSwordWorld")
+			}
+		})
+	}
+}
+
+func TestRegister_Duplicate(t *testing.T) {
+	err := list.Register(newTestDiceBotConstructor("TestCthulhu", "てすとくとぅるふ"), "tcoc")
+	if err != nil {
+		t.Fatalf("登録に失敗: %s", err)
+	}
+	defer list.Unregister("TestCthulhu")
+
+	testcases := []struct {
+		name        string
+		constructor dicebot.DiceBotConstructor
+		aliases     []string
+	}{
+		{"同じゲーム識別子", newTestDiceBotConstructor("TestCthulhu", ""), nil},
+		{"大文字と小文字のみ異なるゲーム識別子", newTestDiceBotConstructor("testcthulhu", ""), nil},
+		{"登録済みの別名", newTestDiceBotConstructor("TestOther", ""), []string{"TCoC"}},
+		{"ゲーム識別子と同じ別名", newTestDiceBotConstructor("TestOther", ""), []string{"TestCthulhu"}},
+		{"重複した別名", newTestDiceBotConstructor("TestOther", ""), []string{"to", "TO"}},
+		{"基本のダイスボット", newTestDiceBotConstructor("DiceBot", ""), nil},
+		{"空の別名", newTestDiceBotConstructor("TestOther", ""), []string{""}},
+		{"コンストラクタなし", nil, nil},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			registerErr := list.Register(test.constructor, test.aliases...)
+			if registerErr == nil {
+				list.Unregister(test.constructor().GameID())
+				t.Fatal("登録できてしまった")
+			}
+		})
+	}
+
+	// 登録に失敗した場合は何も登録されない
+	if _, findErr := list.Find("to"); findErr == nil {
+		t.Error("登録に失敗したダイスボットの別名が見つかった")
+	}
+}
+
+func TestMustRegister_Panic(t *testing.T) {
+	list.MustRegister(newTestDiceBotConstructor("TestPanic", ""))
+	defer list.Unregister("TestPanic")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("panicに陥らなかった")
+		}
+	}()
+
+	list.MustRegister(newTestDiceBotConstructor("TestPanic", ""))
+}
+
+func TestUnregister(t *testing.T) {
+	list.MustRegister(newTestDiceBotConstructor("TestUnregister", ""), "tu")
+
+	if err := list.Unregister("TestUnregister"); err != nil {
+		t.Fatalf("登録解除に失敗: %s", err)
+	}
+
+	for _, name := range []string{"TestUnregister", "tu"} {
+		if _, err := list.Find(name); err == nil {
+			t.Errorf("登録解除後も見つかる: %s", name)
+		}
+	}
+
+	if err := list.Unregister("TestUnregister"); err == nil {
+		t.Error("登録されていないダイスボットの登録を解除できてしまった")
+	}
+
+	// 登録解除後は再登録できる
+	if err := list.Register(newTestDiceBotConstructor("TestUnregister", ""), "tu"); err != nil {
+		t.Fatalf("再登録に失敗: %s", err)
+	}
+	list.Unregister("TestUnregister")
+}
+
+func TestAvailableGameIDs(t *testing.T) {
+	before := list.AvailableGameIDs(false)
+
+	list.MustRegister(newTestDiceBotConstructor("TestAvailable", ""))
+	defer list.Unregister("TestAvailable")
+
+	actual := list.AvailableGameIDs(true)
+	if actual[0] != "DiceBot" {
+		t.Errorf("先頭が基本のダイスボットではない: %v", actual)
+	}
+
+	found := false
+	for _, id := range actual {
+		if id == "TestAvailable" {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("登録したダイスボットが含まれていない: %v", actual)
+	}
+
+	list.Unregister("TestAvailable")
+
+	after := list.AvailableGameIDs(false)
+	if !reflect.DeepEqual(after, before) {
+		t.Errorf("登録解除後の一覧が異なる: got %v, want %v", after, before)
+	}
+}
@@ -0,0 +1,229 @@
+package table
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+)
+
+// 表ファイルの拡張子
+const TableFileExt = ".txt"
+
+// ParseError は表ファイルの構文エラーを表す構造体。
+type ParseError struct {
+	// ファイル名
+	Filename string
+	// 行番号（1から始まる）
+	Line int
+	// エラーの内容
+	Message string
+}
+
+// Error はエラーメッセージを返す。
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s:%d: %s", e.Filename, e.Line, e.Message)
+}
+
+var (
+	// ダイス指定を表す正規表現
+	diceSpecRe = regexp.MustCompile(`\A(?i:(\d+)D(\d+)|D66([NS])?)\z`)
+	// 見出し行（"ダイス:表のタイトル"）を表す正規表現
+	headerRe = regexp.MustCompile(`\A([0-9A-Za-z]+)[:：](.+)\z`)
+	// 項目の行（"出目:結果の文字列"）を表す正規表現
+	itemRe = regexp.MustCompile(`\A(\d+)(?:-(\d+))?[:：](.*)\z`)
+)
+
+// ParseDice はダイス指定を解析し、表のダイスを返す。
+//
+// ダイス指定は "2D6" のような加算ロールか、"D66"、"D66N"、"D66S" のいずれか。
+func ParseDice(spec string) (Dice, error) {
+	matches := diceSpecRe.FindStringSubmatch(strings.TrimSpace(spec))
+	if matches == nil {
+		return Dice{}, fmt.Errorf("invalid dice spec: %q", spec)
+	}
+
+	if matches[1] == "" {
+		switch strings.ToUpper(matches[3]) {
+		case "N":
+			return D66Dice(ast.D66_ORDER_NONE), nil
+		case "S":
+			return D66Dice(ast.D66_ORDER_ASCENDING), nil
+		default:
+			return D66Dice(ast.D66_ORDER_UNSPECIFIED), nil
+		}
+	}
+
+	num, _ := strconv.Atoi(matches[1])
+	sides, _ := strconv.Atoi(matches[2])
+	if num < 1 || sides < 1 {
+		return Dice{}, fmt.Errorf("invalid dice spec: %q", spec)
+	}
+
+	return SumDice(num, sides), nil
+}
+
+// Parse は表ファイルの内容を解析し、表を返す。
+//
+// command: 表を呼び出すコマンド,
+// filename: エラーメッセージに含めるファイル名,
+// r: 表ファイルの内容。
+//
+// 表ファイルの書式は、Ruby版BCDiceの extratables と同じく、
+// 見出し行の後に "出目:結果の文字列" という行を並べたもの。
+// 見出し行は "2D6:表のタイトル" という1行か、表のタイトルの行とダイス指定の行の2行で書く。
+// 出目は "2-4" のように範囲で指定することもできる。
+// 空行は無視される。
+func Parse(command string, filename string, r io.Reader) (*Table, error) {
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+
+	newParseError := func(format string, a ...interface{}) *ParseError {
+		return &ParseError{
+			Filename: filename,
+			Line:     lineNo,
+			Message:  fmt.Sprintf(format, a...),
+		}
+	}
+
+	// 空行を読み飛ばして次の行を返す
+	nextLine := func() (string, bool) {
+		for scanner.Scan() {
+			lineNo++
+
+			line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+			if line != "" {
+				return line, true
+			}
+		}
+
+		return "", false
+	}
+
+	// 見出し行
+	firstLine, found := nextLine()
+	if !found {
+		return nil, newParseError("table title not found")
+	}
+
+	var name string
+	var d Dice
+
+	if m := headerRe.FindStringSubmatch(firstLine); m != nil && diceSpecRe.MatchString(m[1]) {
+		// "2D6:表のタイトル" の形式
+		d, _ = ParseDice(m[1])
+		name = strings.TrimSpace(m[2])
+	} else {
+		// 表のタイトルの行とダイス指定の行が分かれている形式
+		name = firstLine
+
+		diceLine, found := nextLine()
+		if !found {
+			return nil, newParseError("dice spec not found")
+		}
+
+		parsedDice, err := ParseDice(diceLine)
+		if err != nil {
+			return nil, newParseError("%s", err)
+		}
+
+		d = parsedDice
+	}
+
+	// 項目の行
+	items := []Item{}
+	for {
+		line, found := nextLine()
+		if !found {
+			break
+		}
+
+		m := itemRe.FindStringSubmatch(line)
+		if m == nil {
+			return nil, newParseError("invalid item: %q", line)
+		}
+
+		min, _ := strconv.Atoi(m[1])
+		max := min
+		if m[2] != "" {
+			max, _ = strconv.Atoi(m[2])
+		}
+
+		if min > max {
+			return nil, newParseError("invalid range: %d-%d", min, max)
+		}
+
+		items = append(items, Item{
+			Min:  min,
+			Max:  max,
+			Text: strings.TrimSpace(m[3]),
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(items) < 1 {
+		return nil, newParseError("no items")
+	}
+
+	return New(command, name, d, items), nil
+}
+
+// ParseFile は表ファイルを読み込み、表を返す。
+//
+// 表を呼び出すコマンドは、ファイル名から拡張子を除いたものとなる。
+func ParseFile(filename string) (*Table, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	command := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+
+	return Parse(command, filename, f)
+}
+
+// LoadDir は、ディレクトリ内の表ファイルをすべて読み込み、表のスライスを返す。
+//
+// 拡張子が TableFileExt であるファイルを表ファイルとみなす。
+// 表はファイル名の順に並ぶ。
+func LoadDir(dir string) ([]*Table, error) {
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	filenames := []string{}
+	for _, fi := range fileInfos {
+		if fi.IsDir() || filepath.Ext(fi.Name()) != TableFileExt {
+			continue
+		}
+
+		filenames = append(filenames, fi.Name())
+	}
+
+	sort.Strings(filenames)
+
+	tables := make([]*Table, 0, len(filenames))
+	for _, name := range filenames {
+		t, err := ParseFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		tables = append(tables, t)
+	}
+
+	return tables, nil
+}
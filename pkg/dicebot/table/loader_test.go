@@ -0,0 +1,137 @@
+package table_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/table"
+)
+
+func TestParseDice(t *testing.T) {
+	testcases := []struct {
+		spec     string
+		expected table.Dice
+		err      bool
+	}{
+		{"1D6", table.SumDice(1, 6), false},
+		{"2d6", table.SumDice(2, 6), false},
+		{"1D100", table.SumDice(1, 100), false},
+		{"D66", table.D66Dice(ast.D66_ORDER_UNSPECIFIED), false},
+		{"d66n", table.D66Dice(ast.D66_ORDER_NONE), false},
+		{"D66S", table.D66Dice(ast.D66_ORDER_ASCENDING), false},
+		{"0D6", table.Dice{}, true},
+		{"2B6", table.Dice{}, true},
+		{"D66X", table.Dice{}, true},
+		{"", table.Dice{}, true},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.spec, func(t *testing.T) {
+			actual, err := table.ParseDice(test.spec)
+			if err != nil {
+				if !test.err {
+					t.Fatalf("got err: %s", err)
+				}
+
+				return
+			}
+
+			if test.err {
+				t.Fatal("should err")
+			}
+
+			if actual != test.expected {
+				t.Errorf("got %+v, want %+v", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	testcases := []struct {
+		source        string
+		expectedName  string
+		expectedDice  table.Dice
+		expectedItems []table.Item
+	}{
+		{
+			source:       "2D6:遭遇表\n2:ドラゴン\n3-12:ゴブリン\n",
+			expectedName: "遭遇表",
+			expectedDice: table.SumDice(2, 6),
+			expectedItems: []table.Item{
+				{Min: 2, Max: 2, Text: "ドラゴン"},
+				{Min: 3, Max: 12, Text: "ゴブリン"},
+			},
+		},
+		{
+			source:       "天候表\n1D6\n\n1-3：晴れ\n4-6：雨",
+			expectedName: "天候表",
+			expectedDice: table.SumDice(1, 6),
+			expectedItems: []table.Item{
+				{Min: 1, Max: 3, Text: "晴れ"},
+				{Min: 4, Max: 6, Text: "雨"},
+			},
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.expectedName, func(t *testing.T) {
+			actual, err := table.Parse("T", "test.txt", strings.NewReader(test.source))
+			if err != nil {
+				t.Fatalf("got err: %s", err)
+			}
+
+			if actual.Command != "T" {
+				t.Errorf("コマンドが異なる: got %q, want %q", actual.Command, "T")
+			}
+
+			if actual.Name != test.expectedName {
+				t.Errorf("名前が異なる: got %q, want %q", actual.Name, test.expectedName)
+			}
+
+			if actual.Dice != test.expectedDice {
+				t.Errorf("ダイスが異なる: got %+v, want %+v", actual.Dice, test.expectedDice)
+			}
+
+			if !reflect.DeepEqual(actual.Items, test.expectedItems) {
+				t.Errorf("項目が異なる: got %+v, want %+v", actual.Items, test.expectedItems)
+			}
+		})
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	testcases := []struct {
+		name         string
+		source       string
+		expectedLine int
+	}{
+		{"空", "", 0},
+		{"ダイス指定なし", "遭遇表\n", 1},
+		{"ダイス指定が不正", "遭遇表\n2X6\n", 2},
+		{"項目なし", "2D6:遭遇表\n\n", 2},
+		{"項目が不正", "2D6:遭遇表\n2:A\n\nthree:B\n", 4},
+		{"範囲が不正", "2D6:遭遇表\n2:A\n6-3:B\n", 3},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := table.Parse("T", "test.txt", strings.NewReader(test.source))
+			if err == nil {
+				t.Fatal("should err")
+			}
+
+			parseErr, ok := err.(*table.ParseError)
+			if !ok {
+				t.Fatalf("構文エラーではない: %s", err)
+			}
+
+			if parseErr.Line != test.expectedLine {
+				t.Errorf("行番号が異なる: got %d, want %d (%s)",
+					parseErr.Line, test.expectedLine, parseErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+ダイスボットで使う表のパッケージ。
+
+表は、ダイスの出目の範囲と結果の文字列とを対応させたものである。
+ランダムエンカウント表やシーン表など、多くのゲームシステムで使われる。
+*/
+package table
+
+import (
+	"fmt"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/core/object"
+)
+
+// Item は表の項目を表す構造体。
+type Item struct {
+	// 出目の最小値
+	Min int
+	// 出目の最大値
+	Max int
+	// 結果の文字列
+	Text string
+}
+
+// Contains は出目valueが項目の範囲に含まれるかを返す。
+func (i *Item) Contains(value int) bool {
+	return value >= i.Min && value <= i.Max
+}
+
+// 表を表す構造体。
+type Table struct {
+	// 表を呼び出すコマンド
+	Command string
+	// 表の名前
+	Name string
+	// 振るダイス
+	Dice Dice
+	// 表の項目
+	Items []Item
+}
+
+// New は新しい表を返す。
+//
+// command: 表を呼び出すコマンド,
+// name: 表の名前,
+// d: 振るダイス,
+// items: 表の項目。
+func New(command string, name string, d Dice, items []Item) *Table {
+	return &Table{
+		Command: command,
+		Name:    name,
+		Dice:    d,
+		Items:   items,
+	}
+}
+
+// NewWithTexts は、出目の小さい順に並んだ結果の文字列から新しい表を返す。
+//
+// command: 表を呼び出すコマンド,
+// name: 表の名前,
+// d: 振るダイス,
+// texts: 結果の文字列。
+//
+// 結果の文字列の数は、ダイスの出目の種類の数と一致しなければならない。
+func NewWithTexts(command string, name string, d Dice, texts []string) (*Table, error) {
+	values := d.Values()
+	if len(texts) != len(values) {
+		return nil, fmt.Errorf(
+			"%s: number of texts (%d) does not match number of values of %s (%d)",
+			name, len(texts), d, len(values),
+		)
+	}
+
+	items := make([]Item, 0, len(texts))
+	for i, text := range texts {
+		items = append(items, Item{
+			Min:  values[i],
+			Max:  values[i],
+			Text: text,
+		})
+	}
+
+	return New(command, name, d, items), nil
+}
+
+// Lookup は出目valueに対応する項目を返す。
+func (t *Table) Lookup(value int) (*Item, error) {
+	for i := range t.Items {
+		item := &t.Items[i]
+		if item.Contains(value) {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: item not found: %d", t.Name, value)
+}
+
+// Roll はダイスを振り、表から結果を引く。
+//
+// gameID: ゲーム識別子,
+// ev: 評価器。
+//
+// 結果のメッセージは "表の名前(2D6) ＞ 出目 ＞ 結果の文字列" という形式になる。
+func (t *Table) Roll(gameID string, ev *evaluator.Evaluator) (*command.Result, error) {
+	value, rollErr := t.Dice.roll(ev)
+	if rollErr != nil {
+		return nil, rollErr
+	}
+
+	item, lookupErr := t.Lookup(value)
+	if lookupErr != nil {
+		return nil, lookupErr
+	}
+
+	result := &command.Result{
+		GameID:     gameID,
+		RolledDice: ev.RolledDice(),
+	}
+
+	result.AppendMessagePart(fmt.Sprintf("%s(%s)", t.Name, t.Dice))
+	result.AppendMessagePart(fmt.Sprintf("%d", value))
+	result.AppendMessagePart(item.Text)
+
+	return result, nil
+}
+
+// Dice は表で振るダイスを表す構造体。
+type Dice struct {
+	// 振るダイスの数（D66の場合は使わない）
+	Num int
+	// ダイスの面数（D66の場合は使わない）
+	Sides int
+	// D66ダイスかどうか
+	IsD66 bool
+	// D66ダイスの並べ方
+	D66Order ast.D66Order
+}
+
+// SumDice は、sides個の面を持つダイスをnum個振って合計する、表のダイスを返す。
+func SumDice(num int, sides int) Dice {
+	return Dice{
+		Num:   num,
+		Sides: sides,
+	}
+}
+
+// D66Dice は、D66ダイスを振る表のダイスを返す。
+//
+// order: ダイスの並べ方。
+// ast.D66_ORDER_UNSPECIFIED の場合は、評価器に設定されている既定の並べ方に従う。
+func D66Dice(order ast.D66Order) Dice {
+	return Dice{
+		IsD66:    true,
+		D66Order: order,
+	}
+}
+
+// String はダイスの文字列表現を返す。
+func (d Dice) String() string {
+	if d.IsD66 {
+		return "D66"
+	}
+
+	return fmt.Sprintf("%dD%d", d.Num, d.Sides)
+}
+
+// Values は、出目として取り得る値を昇順に並べたスライスを返す。
+func (d Dice) Values() []int {
+	if d.IsD66 {
+		values := make([]int, 0, 36)
+		for tens := 1; tens <= 6; tens++ {
+			for ones := 1; ones <= 6; ones++ {
+				values = append(values, tens*10+ones)
+			}
+		}
+
+		return values
+	}
+
+	if d.Num < 1 || d.Sides < 1 {
+		return []int{}
+	}
+
+	values := make([]int, 0, d.Num*(d.Sides-1)+1)
+	for v := d.Num; v <= d.Num*d.Sides; v++ {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// roll はダイスを振り、出目を返す。
+func (d Dice) roll(ev *evaluator.Evaluator) (int, error) {
+	if d.IsD66 {
+		obj, err := ev.Eval(ast.NewD66(d.D66Order))
+		if err != nil {
+			return 0, err
+		}
+
+		return obj.(*object.Integer).Value, nil
+	}
+
+	rolledDice, err := ev.RollDice(d.Num, d.Sides)
+	if err != nil {
+		return 0, err
+	}
+
+	sum := 0
+	for _, die := range rolledDice {
+		sum += die.Value
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,168 @@
+package table_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/ast"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/dice/roller"
+	"github.com/raa0121/GoBCDice/pkg/core/evaluator"
+	"github.com/raa0121/GoBCDice/pkg/dicebot/table"
+)
+
+// 2D6の表
+var encounterTable = table.New(
+	"ET",
+	"遭遇表",
+	table.SumDice(2, 6),
+	[]table.Item{
+		{Min: 2, Max: 4, Text: "ドラゴン"},
+		{Min: 5, Max: 9, Text: "ゴブリン"},
+		{Min: 10, Max: 12, Text: "宝箱"},
+	},
+)
+
+// 1D6の表
+var weatherTable, _ = table.NewWithTexts(
+	"WT",
+	"天候表",
+	table.SumDice(1, 6),
+	[]string{"快晴", "晴れ", "曇り", "雨", "雷雨", "嵐"},
+)
+
+// D66の表を作る
+func newD66Table(order ast.D66Order) *table.Table {
+	texts := make([]string, 0, 36)
+	for tens := 1; tens <= 6; tens++ {
+		for ones := 1; ones <= 6; ones++ {
+			texts = append(texts, fmt.Sprintf("項目%d%d", tens, ones))
+		}
+	}
+
+	t, _ := table.NewWithTexts("PT", "プロット表", table.D66Dice(order), texts)
+	return t
+}
+
+func TestTable_Roll(t *testing.T) {
+	testcases := []struct {
+		table    *table.Table
+		expected string
+		dice     []dice.Die
+	}{
+		{
+			table:    encounterTable,
+			expected: "DiceBot : 遭遇表(2D6) ＞ 3 ＞ ドラゴン",
+			dice:     []dice.Die{{1, 6}, {2, 6}},
+		},
+		{
+			table:    encounterTable,
+			expected: "DiceBot : 遭遇表(2D6) ＞ 7 ＞ ゴブリン",
+			dice:     []dice.Die{{3, 6}, {4, 6}},
+		},
+		{
+			table:    encounterTable,
+			expected: "DiceBot : 遭遇表(2D6) ＞ 12 ＞ 宝箱",
+			dice:     []dice.Die{{6, 6}, {6, 6}},
+		},
+		{
+			table:    weatherTable,
+			expected: "DiceBot : 天候表(1D6) ＞ 1 ＞ 快晴",
+			dice:     []dice.Die{{1, 6}},
+		},
+		{
+			table:    weatherTable,
+			expected: "DiceBot : 天候表(1D6) ＞ 6 ＞ 嵐",
+			dice:     []dice.Die{{6, 6}},
+		},
+		{
+			table:    newD66Table(ast.D66_ORDER_NONE),
+			expected: "DiceBot : プロット表(D66) ＞ 52 ＞ 項目52",
+			dice:     []dice.Die{{5, 6}, {2, 6}},
+		},
+		{
+			table:    newD66Table(ast.D66_ORDER_ASCENDING),
+			expected: "DiceBot : プロット表(D66) ＞ 25 ＞ 項目25",
+			dice:     []dice.Die{{5, 6}, {2, 6}},
+		},
+	}
+
+	for i, test := range testcases {
+		name := fmt.Sprintf("%d-%s[%s]",
+			i, test.table.Name, dice.FormatDiceWithoutSpaces(test.dice))
+		t.Run(name, func(t *testing.T) {
+			dieFeeder := feeder.NewQueue(test.dice)
+			ev := evaluator.NewEvaluator(roller.New(dieFeeder), evaluator.NewEnvironment())
+
+			r, err := test.table.Roll("DiceBot", ev)
+			if err != nil {
+				t.Fatalf("表を振る際にエラーが発生: %s", err)
+				return
+			}
+
+			actual := r.Message()
+			if actual != test.expected {
+				t.Errorf("結果のメッセージが異なる: got %q, want %q", actual, test.expected)
+			}
+
+			if !reflect.DeepEqual(r.RolledDice, test.dice) {
+				t.Errorf("ダイスロール結果が異なる: got [%s], want [%s]",
+					dice.FormatDice(r.RolledDice), dice.FormatDice(test.dice))
+			}
+		})
+	}
+}
+
+func TestTable_Roll_ItemNotFound(t *testing.T) {
+	tbl := table.New(
+		"XT",
+		"欠けた表",
+		table.SumDice(1, 6),
+		[]table.Item{
+			{Min: 1, Max: 3, Text: "前半"},
+		},
+	)
+
+	dieFeeder := feeder.NewQueue([]dice.Die{{5, 6}})
+	ev := evaluator.NewEvaluator(roller.New(dieFeeder), evaluator.NewEnvironment())
+
+	_, err := tbl.Roll("DiceBot", ev)
+	if err == nil {
+		t.Fatal("エラーが発生しなかった")
+	}
+}
+
+func TestNewWithTexts_WrongNumberOfTexts(t *testing.T) {
+	_, err := table.NewWithTexts(
+		"XT",
+		"短い表",
+		table.SumDice(2, 6),
+		[]string{"A", "B", "C"},
+	)
+	if err == nil {
+		t.Fatal("エラーが発生しなかった")
+	}
+}
+
+func TestDice_String(t *testing.T) {
+	testcases := []struct {
+		dice     table.Dice
+		expected string
+	}{
+		{table.SumDice(1, 6), "1D6"},
+		{table.SumDice(2, 6), "2D6"},
+		{table.SumDice(1, 100), "1D100"},
+		{table.D66Dice(ast.D66_ORDER_UNSPECIFIED), "D66"},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.expected, func(t *testing.T) {
+			actual := test.dice.String()
+			if actual != test.expected {
+				t.Errorf("got %q, want %q", actual, test.expected)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"io/ioutil"
 	"regexp"
-	"strconv"
 	"strings"
 )
 
@@ -23,12 +22,8 @@ type DiceBotTestCase struct {
 	Dice []dice.Die
 }
 
-var (
-	// テストケースのソースコードを表す正規表現
-	sourceRe = regexp.MustCompile("(?s)\\Ainput:\n(.+)\noutput:(.*)\nrand:(.*)")
-	// テストケースのソースコード内のダイス表記を表す正規表現
-	diceRe = regexp.MustCompile(`\A\s*(\d+)/(\d+)\s*\z`)
-)
+// テストケースのソースコードを表す正規表現
+var sourceRe = regexp.MustCompile("(?s)\\Ainput:\n(.+)\noutput:(.*)\nrand:(.*)")
 
 // Parse はテストケースのソースコードを構文解析し、その内容のDiceBotTestCaseを構築して返す。
 // 失敗するとnilを返す。
@@ -60,25 +55,7 @@ func Parse(source string, gameID string, index int) (*DiceBotTestCase, error) {
 
 // ParseDice はテストケースのダイス表記を解析し、振られたダイスのスライスを返す。
 func ParseDice(source string) ([]dice.Die, error) {
-	rolledDice := []dice.Die{}
-
-	if source == "" {
-		return rolledDice, nil
-	}
-
-	diceStrs := strings.Split(source, ",")
-	for i, diceStr := range diceStrs {
-		matches := diceRe.FindStringSubmatch(diceStr)
-		if matches == nil {
-			return nil, fmt.Errorf("ParseDice: #%d: %q: ダイス構文エラー", i+1, diceStr)
-		}
-
-		Value, _ := strconv.Atoi(matches[1])
-		Sides, _ := strconv.Atoi(matches[2])
-		rolledDice = append(rolledDice, dice.Die{Value, Sides})
-	}
-
-	return rolledDice, nil
+	return dice.ParseDice(source)
 }
 
 // ParseFile はテストデータファイルを解析し、テストケースのスライスを返す。
@@ -6,19 +6,43 @@ package testing
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/raa0121/GoBCDice/pkg/bcdice"
+	"github.com/raa0121/GoBCDice/pkg/core/command"
 	"github.com/raa0121/GoBCDice/pkg/core/dice"
 	"github.com/raa0121/GoBCDice/pkg/core/dice/feeder"
+	"github.com/raa0121/GoBCDice/pkg/core/locale"
 )
 
+// Setup はテストケースごとに構築したBCDiceを準備する関数の型。
+type Setup func(b *bcdice.BCDice) error
+
+// SetLocale は、結果のメッセージの言語をlに設定する準備処理を返す。
+func SetLocale(l locale.Locale) Setup {
+	return func(b *bcdice.BCDice) error {
+		b.SetLocale(l)
+		return nil
+	}
+}
+
 // Run はダイスボットのテストを実行する。
 //
 // gameID: ゲーム識別子,
 // t: テストの状態管理。
 // testDataFiles: テストデータファイルのパス,
 func Run(gameID string, t *testing.T, testDataFiles ...string) {
+	RunWithSetup(gameID, t, nil, testDataFiles...)
+}
+
+// RunWithSetup は、BCDiceの準備処理を指定してダイスボットのテストを実行する。
+//
+// gameID: ゲーム識別子,
+// t: テストの状態管理。
+// setup: テストケースごとに構築したBCDiceを準備する関数（nilの場合は何もしない）,
+// testDataFiles: テストデータファイルのパス,
+func RunWithSetup(gameID string, t *testing.T, setup Setup, testDataFiles ...string) {
 	testcases, loadErr := ParseFiles(testDataFiles, gameID)
 	if loadErr != nil {
 		t.Fatalf("テストデータファイルの読み込み失敗: %s", loadErr)
@@ -37,11 +61,22 @@ func Run(gameID string, t *testing.T, testDataFiles ...string) {
 			f := feeder.NewQueue(test.Dice)
 			b := bcdice.New(f)
 
+			if err := b.SetDiceBotByGameID(test.GameID); err != nil {
+				t.Fatalf("ダイスボットの設定に失敗: %s", err)
+				return
+			}
+
+			if setup != nil {
+				if err := setup(b); err != nil {
+					t.Fatalf("準備処理のエラー: %s", err)
+					return
+				}
+			}
+
 			// TODO: エラーが発生することの予想を明示できるようにする
 			expectErr := (test.Output == "")
 
-			// TODO: 入力文字列のすべての行のコマンドを実行する
-			result, commandErr := b.ExecuteCommand(test.Input[0])
+			results, commandErr := b.ExecuteCommandLines(strings.Join(test.Input, "\n"))
 			if commandErr != nil {
 				if !expectErr {
 					// 予期せぬエラー
@@ -59,13 +94,7 @@ func Run(gameID string, t *testing.T, testDataFiles ...string) {
 			}
 
 			expected := test.Output
-
-			var actual string
-			if result.IsSecret {
-				actual = fmt.Sprintf("%s###secret dice###", result.Message())
-			} else {
-				actual = result.Message()
-			}
+			actual := joinResultMessages(results)
 
 			if actual != expected {
 				t.Errorf("got: %q, want: %q", actual, expected)
@@ -78,6 +107,26 @@ func Run(gameID string, t *testing.T, testDataFiles ...string) {
 	}
 }
 
+// joinResultMessages は、各行のコマンドの実行結果のメッセージを改行で連結して返す。
+// 実行に失敗した行は無視する。
+func joinResultMessages(results []*command.Result) string {
+	messages := make([]string, 0, len(results))
+
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+
+		if result.IsSecret {
+			messages = append(messages, fmt.Sprintf("%s###secret dice###", result.Message()))
+		} else {
+			messages = append(messages, result.Message())
+		}
+	}
+
+	return strings.Join(messages, "\n")
+}
+
 // JoinWithTestData はbasenamesの各要素の先頭に "testdata/" を追加したスライスを返す。
 func JoinWithTestData(basenames []string) []string {
 	files := make([]string, 0, len(basenames))
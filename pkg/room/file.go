@@ -0,0 +1,148 @@
+package room
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+)
+
+// ルームIDとして有効な文字列を表す正規表現
+//
+// ファイル名に使用するため、ディレクトリの外を指せないように制限する。
+var validIDRe = regexp.MustCompile(`\A[0-9a-f]+\z`)
+
+// ルームをディレクトリ内のJSONファイルとして保存する構造体。
+//
+// 各ルームは "ルームID.json" というファイルに保存される。
+type FileStore struct {
+	// 保存先のディレクトリ
+	dir string
+	// ファイルの読み書きを保護するためのミューテックス
+	mu sync.Mutex
+}
+
+// FileStoreがStoreインターフェースを実装しているかの確認
+var _ Store = (*FileStore)(nil)
+
+// NewFileStore は、ディレクトリdirにルームを保存するFileStoreを返す。
+// ディレクトリが存在しない場合は作成する。
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &FileStore{
+		dir: dir,
+	}, nil
+}
+
+// Dir は保存先のディレクトリを返す。
+func (s *FileStore) Dir() string {
+	return s.dir
+}
+
+// Create はルームを新しく保存する。
+func (s *FileStore) Create(r *Room) error {
+	if !validIDRe.MatchString(r.ID) {
+		return ErrNotFound
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := os.Stat(s.path(r.ID)); err == nil {
+		return ErrAlreadyExists
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	return s.write(r)
+}
+
+// Get は指定されたIDのルームを読み込んで返す。
+func (s *FileStore) Get(id string) (*Room, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.read(id)
+}
+
+// Update は、指定されたIDのルームに対して関数fを適用し、その結果を保存する。
+func (s *FileStore) Update(id string, f func(r *Room) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.read(id)
+	if err != nil {
+		return err
+	}
+
+	if err := f(r); err != nil {
+		return err
+	}
+
+	return s.write(r)
+}
+
+// path はルームを保存するファイルのパスを返す。
+func (s *FileStore) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+// read はルームをファイルから読み込む。
+func (s *FileStore) read(id string) (*Room, error) {
+	if !validIDRe.MatchString(id) {
+		return nil, ErrNotFound
+	}
+
+	content, err := ioutil.ReadFile(s.path(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	var r Room
+	if err := json.Unmarshal(content, &r); err != nil {
+		return nil, err
+	}
+
+	if r.Rolls == nil {
+		r.Rolls = []*Roll{}
+	}
+
+	return &r, nil
+}
+
+// write はルームをファイルに書き込む。
+//
+// 書き込みの途中で失敗しても既存のファイルが壊れないように、
+// 一時ファイルに書き込んでから置き換える。
+func (s *FileStore) write(r *Room) error {
+	content, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(s.dir, r.ID+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path(r.ID))
+}
@@ -0,0 +1,72 @@
+package room
+
+import (
+	"sync"
+)
+
+// ルームをメモリ上に保存する構造体。
+//
+// プロセスが終了するとルームは失われる。
+type MemoryStore struct {
+	// ルームの表を保護するためのミューテックス
+	mu sync.Mutex
+	// ルームIDとルームの対応
+	rooms map[string]*Room
+}
+
+// MemoryStoreがStoreインターフェースを実装しているかの確認
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore は空のMemoryStoreを返す。
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		rooms: map[string]*Room{},
+	}
+}
+
+// Create はルームを新しく保存する。
+func (s *MemoryStore) Create(r *Room) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.rooms[r.ID]; exists {
+		return ErrAlreadyExists
+	}
+
+	s.rooms[r.ID] = r.Clone()
+
+	return nil
+}
+
+// Get は指定されたIDのルームのコピーを返す。
+func (s *MemoryStore) Get(id string) (*Room, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, found := s.rooms[id]
+	if !found {
+		return nil, ErrNotFound
+	}
+
+	return r.Clone(), nil
+}
+
+// Update は、指定されたIDのルームに対して関数fを適用し、その結果を保存する。
+func (s *MemoryStore) Update(id string, f func(r *Room) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, found := s.rooms[id]
+	if !found {
+		return ErrNotFound
+	}
+
+	updated := r.Clone()
+	if err := f(updated); err != nil {
+		return err
+	}
+
+	s.rooms[id] = updated
+
+	return nil
+}
@@ -0,0 +1,160 @@
+/*
+オンラインセッションの卓（ルーム）の状態を扱うパッケージ。
+
+ルームは、選択されたゲームシステム、ダイスロールの履歴、GM用のトークンを保持する。
+シークレットロールの結果は、GMが公開するまでGM以外には隠される。
+ルームの保存はStoreインターフェースを通じて行う。
+*/
+package room
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	dicebotlist "github.com/raa0121/GoBCDice/pkg/dicebot/list"
+)
+
+const (
+	// ルームIDのバイト数
+	idBytes = 8
+	// GM用トークンのバイト数
+	gmTokenBytes = 16
+)
+
+// Roll はルームで行われたダイスロールを表す構造体。
+type Roll struct {
+	// ルーム内での通し番号（1から始まる）
+	ID int `json:"id"`
+	// ダイスロールが行われた時刻
+	Time time.Time `json:"time"`
+	// 実行されたコマンド
+	Command string `json:"command"`
+	// コマンドの実行結果
+	Result *command.Result `json:"result"`
+	// シークレットロールが公開されたかどうか
+	Revealed bool `json:"revealed"`
+}
+
+// IsHidden は、GM以外に結果を隠すべきかどうかを返す。
+// 公開されていないシークレットロールの場合にtrueを返す。
+func (r *Roll) IsHidden() bool {
+	return r.Result.IsSecret && !r.Revealed
+}
+
+// clone はダイスロールのコピーを返す。
+//
+// コマンドの実行結果は、詳細や繰り返しの各回の結果も含めてコピーする。
+func (r *Roll) clone() *Roll {
+	copiedRoll := *r
+	copiedRoll.Result = r.Result.Clone()
+
+	return &copiedRoll
+}
+
+// Room はルームを表す構造体。
+type Room struct {
+	// ルームID
+	ID string `json:"id"`
+	// 選択されたゲームシステムのゲーム識別子
+	GameID string `json:"gameId"`
+	// GM用のトークン
+	GMToken string `json:"gmToken"`
+	// ルームが作成された時刻
+	CreatedAt time.Time `json:"createdAt"`
+	// ダイスロールの履歴
+	Rolls []*Roll `json:"rolls"`
+}
+
+// New は、gameIDで指定されたゲームシステムを使用する新しいルームを構築する。
+//
+// ルームIDとGM用のトークンはランダムに生成される。
+// ゲームシステムが見つからなかった場合はエラーを返す。
+func New(gameID string, now time.Time) (*Room, error) {
+	// 別名で指定された場合もゲーム識別子に揃える
+	diceBotConstructor, err := dicebotlist.Find(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := randomHex(idBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	gmToken, err := randomHex(gmTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Room{
+		ID:        id,
+		GameID:    diceBotConstructor().GameID(),
+		GMToken:   gmToken,
+		CreatedAt: now,
+		Rolls:     []*Roll{},
+	}, nil
+}
+
+// IsGM は、tokenがGM用のトークンと一致するかどうかを返す。
+func (r *Room) IsGM(token string) bool {
+	if token == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(r.GMToken)) == 1
+}
+
+// AddRoll は、ダイスロールを履歴に追加し、追加したダイスロールを返す。
+func (r *Room) AddRoll(c string, result *command.Result, t time.Time) *Roll {
+	roll := &Roll{
+		ID:      len(r.Rolls) + 1,
+		Time:    t,
+		Command: c,
+		Result:  result,
+	}
+
+	r.Rolls = append(r.Rolls, roll)
+
+	return roll
+}
+
+// RevealSecretRolls は、公開されていないシークレットロールをすべて公開し、
+// 公開したダイスロールを返す。
+func (r *Room) RevealSecretRolls() []*Roll {
+	revealed := []*Roll{}
+
+	for _, roll := range r.Rolls {
+		if roll.IsHidden() {
+			roll.Revealed = true
+			revealed = append(revealed, roll)
+		}
+	}
+
+	return revealed
+}
+
+// Clone はルームのコピーを返す。
+// ダイスロールの履歴もコピーされる。
+func (r *Room) Clone() *Room {
+	copiedRoom := *r
+	copiedRoom.Rolls = make([]*Roll, 0, len(r.Rolls))
+
+	for _, roll := range r.Rolls {
+		copiedRoom.Rolls = append(copiedRoom.Rolls, roll.clone())
+	}
+
+	return &copiedRoom
+}
+
+// randomHex は、nバイトの乱数を16進数で表した文字列を返す。
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
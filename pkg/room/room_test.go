@@ -0,0 +1,189 @@
+package room_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	_ "github.com/raa0121/GoBCDice/pkg/dicebot/gamesystem/all"
+	"github.com/raa0121/GoBCDice/pkg/room"
+)
+
+// テストで使用する時刻
+var testTime = time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC)
+
+func TestNew(t *testing.T) {
+	r, err := room.New("CoC7th", testTime)
+	if err != nil {
+		t.Fatalf("got err: %s", err)
+	}
+
+	// 別名はゲーム識別子に揃えられる
+	if r.GameID != "Cthulhu7th" {
+		t.Errorf("wrong game ID: got %q, want %q", r.GameID, "Cthulhu7th")
+	}
+
+	if r.ID == "" || r.GMToken == "" {
+		t.Error("ルームIDまたはGM用のトークンが生成されていない")
+	}
+
+	if len(r.Rolls) != 0 {
+		t.Errorf("履歴が空ではない: %v", r.Rolls)
+	}
+}
+
+func TestNew_UnknownGameID(t *testing.T) {
+	if _, err := room.New("Unknown", testTime); err == nil {
+		t.Fatal("エラーが発生しませんでした")
+	}
+}
+
+func TestRoom_IsGM(t *testing.T) {
+	r, err := room.New("DiceBot", testTime)
+	if err != nil {
+		t.Fatalf("got err: %s", err)
+	}
+
+	if !r.IsGM(r.GMToken) {
+		t.Error("GM用のトークンが認められない")
+	}
+
+	for _, token := range []string{"", "wrong", r.ID} {
+		if r.IsGM(token) {
+			t.Errorf("誤ったトークンが認められた: %q", token)
+		}
+	}
+}
+
+func TestRoom_RevealSecretRolls(t *testing.T) {
+	r, err := room.New("DiceBot", testTime)
+	if err != nil {
+		t.Fatalf("got err: %s", err)
+	}
+
+	r.AddRoll("2D6", &command.Result{}, testTime)
+	secret := r.AddRoll("S2D6", &command.Result{IsSecret: true}, testTime)
+
+	if secret.ID != 2 {
+		t.Errorf("wrong roll ID: got %d, want %d", secret.ID, 2)
+	}
+
+	if !secret.IsHidden() {
+		t.Fatal("シークレットロールが隠されていない")
+	}
+
+	revealed := r.RevealSecretRolls()
+	if len(revealed) != 1 || revealed[0] != secret {
+		t.Fatalf("公開されたダイスロールが異なる: %v", revealed)
+	}
+
+	if secret.IsHidden() {
+		t.Error("公開したシークレットロールが隠されている")
+	}
+
+	if again := r.RevealSecretRolls(); len(again) != 0 {
+		t.Errorf("公開済みのダイスロールが再び公開された: %v", again)
+	}
+}
+
+func TestRoom_Clone(t *testing.T) {
+	r, err := room.New("DiceBot", testTime)
+	if err != nil {
+		t.Fatalf("got err: %s", err)
+	}
+
+	r.AddRoll("S2D6", &command.Result{IsSecret: true, MessageParts: []string{"(2D6)"}}, testTime)
+
+	c := r.Clone()
+	c.RevealSecretRolls()
+	c.Rolls[0].Result.MessageParts[0] = "changed"
+	c.AddRoll("1D6", &command.Result{}, testTime)
+
+	if len(r.Rolls) != 1 {
+		t.Errorf("コピー元の履歴が変更された: %v", r.Rolls)
+	}
+
+	if !r.Rolls[0].IsHidden() {
+		t.Error("コピー元のシークレットロールが公開された")
+	}
+
+	if r.Rolls[0].Result.MessageParts[0] != "(2D6)" {
+		t.Error("コピー元の結果が変更された")
+	}
+}
+
+func TestRoom_Clone_DetailAndResults(t *testing.T) {
+	r, err := room.New("DiceBot", testTime)
+	if err != nil {
+		t.Fatalf("got err: %s", err)
+	}
+
+	total := 7
+	success := true
+	rerollOf := 0
+	inner := &command.Result{
+		MessageParts: []string{"(2D6>=7)"},
+		Detail: &command.Detail{
+			Groups: []*command.RollGroup{
+				{
+					Notation: "2D6",
+					Sides:    6,
+					Dice: []*command.DieDetail{
+						{Value: 3, Rerolls: []int{6}, Success: &success},
+						{Value: 4},
+					},
+					Sum:      7,
+					RerollOf: &rerollOf,
+				},
+			},
+			Comparison: &command.Comparison{Operator: ">=", Target: 7},
+			Total:      &total,
+		},
+	}
+	r.AddRoll("x1 2D6>=7", command.NewRepeatResult("DiceBot", []*command.Result{inner}), testTime)
+
+	c := r.Clone()
+	copied := c.Rolls[0].Result.Results[0]
+	*copied.Detail.Total = 0
+	*copied.Detail.Groups[0].RerollOf = 1
+	*copied.Detail.Groups[0].Dice[0].Success = false
+	copied.Detail.Groups[0].Dice[0].Rerolls[0] = 1
+	copied.Detail.Groups[0].Dice[1].Value = 1
+	copied.Detail.Comparison.Target = 12
+	copied.MessageParts[0] = "changed"
+	c.Rolls[0].Result.Results[0] = &command.Result{}
+
+	original := r.Rolls[0].Result.Results[0]
+	if original != inner {
+		t.Fatal("コピー元の各回の実行結果が変更された")
+	}
+
+	if *inner.Detail.Total != 7 {
+		t.Error("コピー元の合計値が変更された")
+	}
+
+	group := inner.Detail.Groups[0]
+	if *group.RerollOf != 0 {
+		t.Error("コピー元の振り足しのきっかけが変更された")
+	}
+
+	if !*group.Dice[0].Success {
+		t.Error("コピー元の成功判定の結果が変更された")
+	}
+
+	if group.Dice[0].Rerolls[0] != 6 {
+		t.Error("コピー元の振り足しの連鎖が変更された")
+	}
+
+	if group.Dice[1].Value != 4 {
+		t.Error("コピー元の出目が変更された")
+	}
+
+	if inner.Detail.Comparison.Target != 7 {
+		t.Error("コピー元の比較が変更された")
+	}
+
+	if inner.MessageParts[0] != "(2D6>=7)" {
+		t.Error("コピー元の各回のメッセージが変更された")
+	}
+}
@@ -0,0 +1,31 @@
+package room
+
+import (
+	"errors"
+)
+
+var (
+	// ErrNotFound はルームが見つからなかったことを表すエラー。
+	ErrNotFound = errors.New("room not found")
+	// ErrAlreadyExists は同じIDのルームがすでに存在することを表すエラー。
+	ErrAlreadyExists = errors.New("room already exists")
+)
+
+// Store はルームの保存先のインターフェース。
+//
+// 実装は複数のゴルーチンから安全に使用できなければならない。
+type Store interface {
+	// Create はルームを新しく保存する。
+	// 同じIDのルームがすでに存在する場合は ErrAlreadyExists を返す。
+	Create(r *Room) error
+
+	// Get は指定されたIDのルームのコピーを返す。
+	// ルームが存在しない場合は ErrNotFound を返す。
+	Get(id string) (*Room, error)
+
+	// Update は、指定されたIDのルームに対して関数fを適用し、その結果を保存する。
+	// 読み込みから保存までは他の更新と排他的に行われる。
+	// fがエラーを返した場合は保存せず、そのエラーを返す。
+	// ルームが存在しない場合は ErrNotFound を返す。
+	Update(id string, f func(r *Room) error) error
+}
@@ -0,0 +1,211 @@
+package room_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/raa0121/GoBCDice/pkg/core/command"
+	"github.com/raa0121/GoBCDice/pkg/core/dice"
+	"github.com/raa0121/GoBCDice/pkg/room"
+)
+
+// testStores は、各Storeの実装に対して同じテストを行う。
+func testStores(t *testing.T, test func(t *testing.T, s room.Store)) {
+	t.Run("MemoryStore", func(t *testing.T) {
+		test(t, room.NewMemoryStore())
+	})
+
+	t.Run("FileStore", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "GoBCDice-room")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		s, err := room.NewFileStore(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		test(t, s)
+	})
+}
+
+// newTestRoom はテスト用のルームを構築する。
+func newTestRoom(t *testing.T) *room.Room {
+	r, err := room.New("DiceBot", testTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
+
+func TestStore_CreateAndGet(t *testing.T) {
+	testStores(t, func(t *testing.T, s room.Store) {
+		r := newTestRoom(t)
+		r.AddRoll("S2D6", &command.Result{
+			GameID:       "DiceBot",
+			MessageParts: []string{"(2D6)", "7[3,4]", "7"},
+			RolledDice:   []dice.Die{{3, 6}, {4, 6}},
+			IsSecret:     true,
+		}, testTime)
+
+		if err := s.Create(r); err != nil {
+			t.Fatalf("got err: %s", err)
+		}
+
+		actual, err := s.Get(r.ID)
+		if err != nil {
+			t.Fatalf("got err: %s", err)
+		}
+
+		if actual.GameID != r.GameID || actual.GMToken != r.GMToken || !actual.CreatedAt.Equal(r.CreatedAt) {
+			t.Errorf("wrong room: got %+v, want %+v", actual, r)
+		}
+
+		if len(actual.Rolls) != 1 {
+			t.Fatalf("wrong number of rolls: got %d, want %d", len(actual.Rolls), 1)
+		}
+
+		if msg := actual.Rolls[0].Result.Message(); msg != "DiceBot : (2D6) ＞ 7[3,4] ＞ 7" {
+			t.Errorf("wrong message: got %q", msg)
+		}
+
+		if !actual.Rolls[0].IsHidden() {
+			t.Error("シークレットロールが隠されていない")
+		}
+	})
+}
+
+func TestStore_CreateDuplicate(t *testing.T) {
+	testStores(t, func(t *testing.T, s room.Store) {
+		r := newTestRoom(t)
+
+		if err := s.Create(r); err != nil {
+			t.Fatalf("got err: %s", err)
+		}
+
+		if err := s.Create(r); !errors.Is(err, room.ErrAlreadyExists) {
+			t.Errorf("wrong err: got %v, want %v", err, room.ErrAlreadyExists)
+		}
+	})
+}
+
+func TestStore_NotFound(t *testing.T) {
+	testStores(t, func(t *testing.T, s room.Store) {
+		for _, id := range []string{"0123abcd", "../secret", ""} {
+			if _, err := s.Get(id); !errors.Is(err, room.ErrNotFound) {
+				t.Errorf("Get(%q): wrong err: got %v, want %v", id, err, room.ErrNotFound)
+			}
+
+			err := s.Update(id, func(r *room.Room) error { return nil })
+			if !errors.Is(err, room.ErrNotFound) {
+				t.Errorf("Update(%q): wrong err: got %v, want %v", id, err, room.ErrNotFound)
+			}
+		}
+	})
+}
+
+func TestStore_Update(t *testing.T) {
+	testStores(t, func(t *testing.T, s room.Store) {
+		r := newTestRoom(t)
+		if err := s.Create(r); err != nil {
+			t.Fatalf("got err: %s", err)
+		}
+
+		err := s.Update(r.ID, func(r *room.Room) error {
+			r.AddRoll("S1D6", &command.Result{IsSecret: true}, testTime)
+			r.RevealSecretRolls()
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("got err: %s", err)
+		}
+
+		// 関数がエラーを返した場合は保存されない
+		expectedErr := errors.New("abort")
+		err = s.Update(r.ID, func(r *room.Room) error {
+			r.AddRoll("1D6", &command.Result{}, testTime)
+			return expectedErr
+		})
+		if err != expectedErr {
+			t.Fatalf("wrong err: got %v, want %v", err, expectedErr)
+		}
+
+		actual, err := s.Get(r.ID)
+		if err != nil {
+			t.Fatalf("got err: %s", err)
+		}
+
+		if len(actual.Rolls) != 1 {
+			t.Fatalf("wrong number of rolls: got %d, want %d", len(actual.Rolls), 1)
+		}
+
+		if actual.Rolls[0].IsHidden() {
+			t.Error("公開したシークレットロールが隠されている")
+		}
+	})
+}
+
+// 複数のゴルーチンから同時に更新しても、更新が失われないことを確認する。
+func TestStore_UpdateConcurrently(t *testing.T) {
+	testStores(t, func(t *testing.T, s room.Store) {
+		r := newTestRoom(t)
+		if err := s.Create(r); err != nil {
+			t.Fatalf("got err: %s", err)
+		}
+
+		const numOfGoroutines = 8
+		const numOfRollsPerGoroutine = 10
+
+		var wg sync.WaitGroup
+		errs := make(chan error, numOfGoroutines)
+
+		for i := 0; i < numOfGoroutines; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				for j := 0; j < numOfRollsPerGoroutine; j++ {
+					err := s.Update(r.ID, func(r *room.Room) error {
+						r.AddRoll(fmt.Sprintf("%d-%d", i, j), &command.Result{}, testTime)
+						return nil
+					})
+					if err != nil {
+						errs <- err
+						return
+					}
+				}
+			}(i)
+		}
+
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			t.Error(err)
+		}
+
+		actual, err := s.Get(r.ID)
+		if err != nil {
+			t.Fatalf("got err: %s", err)
+		}
+
+		if len(actual.Rolls) != numOfGoroutines*numOfRollsPerGoroutine {
+			t.Errorf("wrong number of rolls: got %d, want %d",
+				len(actual.Rolls), numOfGoroutines*numOfRollsPerGoroutine)
+		}
+
+		for i, roll := range actual.Rolls {
+			if roll.ID != i+1 {
+				t.Errorf("wrong roll ID: got %d, want %d", roll.ID, i+1)
+			}
+		}
+	})
+}